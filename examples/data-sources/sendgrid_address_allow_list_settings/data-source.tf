@@ -0,0 +1,9 @@
+data "sendgrid_address_allow_list_settings" "example" {}
+
+output "address_allow_list_enabled" {
+  value = data.sendgrid_address_allow_list_settings.example.enabled
+}
+
+output "address_allow_list_list" {
+  value = data.sendgrid_address_allow_list_settings.example.list
+}
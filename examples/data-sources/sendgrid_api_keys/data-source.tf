@@ -0,0 +1,7 @@
+data "sendgrid_api_keys" "example" {
+  name_regex = "^ci-"
+}
+
+output "api_key_names" {
+  value = data.sendgrid_api_keys.example.api_keys[*].name
+}
@@ -0,0 +1,9 @@
+data "sendgrid_bcc_settings" "example" {}
+
+output "bcc_enabled" {
+  value = data.sendgrid_bcc_settings.example.enabled
+}
+
+output "bcc_email" {
+  value = data.sendgrid_bcc_settings.example.email
+}
@@ -0,0 +1,8 @@
+# The blocks recorded since 2025-01-01 00:00:00 UTC
+data "sendgrid_blocks" "example" {
+  start_time = 1735689600
+}
+
+output "blocks_emails" {
+  value = data.sendgrid_blocks.example.blocks[*].email
+}
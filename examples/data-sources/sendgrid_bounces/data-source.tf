@@ -0,0 +1,8 @@
+# The bounces recorded since 2025-01-01 00:00:00 UTC
+data "sendgrid_bounces" "example" {
+  start_time = 1735689600
+}
+
+output "bounces_emails" {
+  value = data.sendgrid_bounces.example.bounces[*].email
+}
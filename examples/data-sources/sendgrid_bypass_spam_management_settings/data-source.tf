@@ -0,0 +1,5 @@
+data "sendgrid_bypass_spam_management_settings" "example" {}
+
+output "bypass_spam_management_enabled" {
+  value = data.sendgrid_bypass_spam_management_settings.example.enabled
+}
@@ -0,0 +1,5 @@
+data "sendgrid_bypass_unsubscribe_management_settings" "example" {}
+
+output "bypass_unsubscribe_management_enabled" {
+  value = data.sendgrid_bypass_unsubscribe_management_settings.example.enabled
+}
@@ -5,3 +5,12 @@ data "sendgrid_design" "example" {
 output "design_name" {
   value = data.sendgrid_design.example.name
 }
+
+# Look up by name instead of id
+data "sendgrid_design" "by_name" {
+  name = "welcome"
+}
+
+output "design_id" {
+  value = data.sendgrid_design.by_name.id
+}
@@ -0,0 +1,8 @@
+data "sendgrid_designs" "example" {
+  name_regex   = "^campaign-"
+  on_behalf_of = "example-subuser"
+}
+
+output "design_ids" {
+  value = data.sendgrid_designs.example.designs[*].id
+}
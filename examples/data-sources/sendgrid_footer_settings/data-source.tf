@@ -0,0 +1,13 @@
+data "sendgrid_footer_settings" "example" {}
+
+output "footer_enabled" {
+  value = data.sendgrid_footer_settings.example.enabled
+}
+
+output "footer_html_content" {
+  value = data.sendgrid_footer_settings.example.html_content
+}
+
+output "footer_plain_content" {
+  value = data.sendgrid_footer_settings.example.plain_content
+}
@@ -0,0 +1,9 @@
+data "sendgrid_forward_bounce_settings" "example" {}
+
+output "forward_bounce_enabled" {
+  value = data.sendgrid_forward_bounce_settings.example.enabled
+}
+
+output "forward_bounce_email" {
+  value = data.sendgrid_forward_bounce_settings.example.email
+}
@@ -0,0 +1,9 @@
+data "sendgrid_forward_spam_settings" "example" {}
+
+output "forward_spam_enabled" {
+  value = data.sendgrid_forward_spam_settings.example.enabled
+}
+
+output "forward_spam_email" {
+  value = data.sendgrid_forward_spam_settings.example.email
+}
@@ -0,0 +1,9 @@
+data "sendgrid_google_analytics_settings" "example" {}
+
+output "google_analytics_enabled" {
+  value = data.sendgrid_google_analytics_settings.example.enabled
+}
+
+output "google_analytics_utm_campaign" {
+  value = data.sendgrid_google_analytics_settings.example.utm_campaign
+}
@@ -0,0 +1,8 @@
+# The invalid emails recorded since 2025-01-01 00:00:00 UTC
+data "sendgrid_invalid_emails" "example" {
+  start_time = 1735689600
+}
+
+output "invalid_emails_emails" {
+  value = data.sendgrid_invalid_emails.example.invalid_emails[*].email
+}
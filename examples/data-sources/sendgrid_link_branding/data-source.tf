@@ -9,3 +9,14 @@ output "domain" {
 output "dns" {
   value = data.sendgrid_link_branding.example.dns
 }
+
+# Look up by domain instead of id
+data "sendgrid_link_branding" "by_domain" {
+  domain   = "example.com"
+  username = "example-subuser"
+}
+
+# Look up the default branded link
+data "sendgrid_link_branding" "default" {
+  default = true
+}
@@ -0,0 +1,5 @@
+data "sendgrid_open_tracking_settings" "example" {}
+
+output "open_tracking_enabled" {
+  value = data.sendgrid_open_tracking_settings.example.enabled
+}
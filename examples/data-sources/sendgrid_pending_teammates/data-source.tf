@@ -0,0 +1,7 @@
+data "sendgrid_pending_teammates" "expired" {
+  expired = true
+}
+
+output "expired_invitations" {
+  value = data.sendgrid_pending_teammates.expired.pending_teammates[*].email
+}
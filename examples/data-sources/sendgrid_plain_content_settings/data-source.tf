@@ -0,0 +1,5 @@
+data "sendgrid_plain_content_settings" "example" {}
+
+output "plain_content_enabled" {
+  value = data.sendgrid_plain_content_settings.example.enabled
+}
@@ -13,3 +13,13 @@ output "subdomain" {
 output "users" {
   value = data.sendgrid_reverse_dns.example.users
 }
+
+# Validate the reverse DNS on every read, e.g. to monitor it.
+data "sendgrid_reverse_dns" "monitored" {
+  id       = "123456"
+  validate = true
+}
+
+output "validation_failure_reason" {
+  value = data.sendgrid_reverse_dns.monitored.validation_failure_reason
+}
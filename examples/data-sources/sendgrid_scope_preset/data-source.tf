@@ -0,0 +1,19 @@
+data "sendgrid_scope_preset" "developer" {
+  name            = "developer"
+  extra_scopes    = ["user.email.read"]
+  excluded_scopes = ["api_keys.create", "api_keys.delete", "api_keys.update"]
+}
+
+resource "sendgrid_teammate" "developer" {
+  email  = "developer@example.com"
+  scopes = data.sendgrid_scope_preset.developer.scopes
+}
+
+data "sendgrid_scope_preset" "mail_send_only" {
+  name = "mail_send_only"
+}
+
+resource "sendgrid_api_key" "app" {
+  name   = "app"
+  scopes = data.sendgrid_scope_preset.mail_send_only.scopes
+}
@@ -0,0 +1,7 @@
+data "sendgrid_scopes" "example" {}
+
+# Grant a read-only API key every read scope the provider's API key has.
+resource "sendgrid_api_key" "read_only" {
+  name   = "read-only"
+  scopes = [for s in data.sendgrid_scopes.example.scopes : s if endswith(s, ".read")]
+}
@@ -5,3 +5,24 @@ data "sendgrid_sender_authentication" "example" {
 output "domain" {
   value = data.sendgrid_sender_authentication.example.domain
 }
+
+# Look up by domain instead of id, for example to publish its DNS records
+data "sendgrid_sender_authentication" "by_domain" {
+  domain    = "example.com"
+  subdomain = "em"
+}
+
+resource "aws_route53_record" "sendgrid" {
+  for_each = { for r in data.sendgrid_sender_authentication.by_domain.dns : r.host => r }
+
+  zone_id = "Z0123456789ABCDEFGHIJ"
+  name    = each.value.host
+  type    = upper(each.value.type)
+  ttl     = 300
+  records = [each.value.data]
+}
+
+# Look up the default authenticated domain
+data "sendgrid_sender_authentication" "default" {
+  default = true
+}
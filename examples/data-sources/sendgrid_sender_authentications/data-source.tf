@@ -0,0 +1,8 @@
+data "sendgrid_sender_authentications" "example" {
+  domain           = "example.com"
+  exclude_subusers = true
+}
+
+output "invalid_domains" {
+  value = [for d in data.sendgrid_sender_authentications.example.sender_authentications : d.id if !d.valid]
+}
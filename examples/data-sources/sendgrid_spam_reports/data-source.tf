@@ -0,0 +1,8 @@
+# The spam reports recorded since 2025-01-01 00:00:00 UTC
+data "sendgrid_spam_reports" "example" {
+  start_time = 1735689600
+}
+
+output "spam_reports_emails" {
+  value = data.sendgrid_spam_reports.example.spam_reports[*].email
+}
@@ -0,0 +1,9 @@
+data "sendgrid_subscription_tracking_settings" "example" {}
+
+output "subscription_tracking_enabled" {
+  value = data.sendgrid_subscription_tracking_settings.example.enabled
+}
+
+output "subscription_tracking_url" {
+  value = data.sendgrid_subscription_tracking_settings.example.url
+}
@@ -0,0 +1,10 @@
+data "sendgrid_subuser_credits" "marketing" {
+  username = "marketing"
+}
+
+check "marketing_send_budget" {
+  assert {
+    condition     = data.sendgrid_subuser_credits.marketing.remain >= data.sendgrid_subuser_credits.marketing.total / 10
+    error_message = "The marketing subuser has used more than 90% of its credits."
+  }
+}
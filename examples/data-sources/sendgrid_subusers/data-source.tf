@@ -0,0 +1,8 @@
+data "sendgrid_subusers" "disabled" {
+  username_regex = "^marketing-"
+  disabled       = true
+}
+
+output "disabled_subusers" {
+  value = data.sendgrid_subusers.disabled.subusers[*].username
+}
@@ -0,0 +1,9 @@
+data "sendgrid_teammate_access_requests" "example" {
+  email_regex = "@example\\.com$"
+}
+
+output "open_access_requests" {
+  value = {
+    for r in data.sendgrid_teammate_access_requests.example.access_requests : r.id => "${r.email} requests ${r.scope_group_name}"
+  }
+}
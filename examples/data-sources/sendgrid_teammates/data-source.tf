@@ -0,0 +1,7 @@
+data "sendgrid_teammates" "admins" {
+  user_type = "admin"
+}
+
+output "admin_emails" {
+  value = data.sendgrid_teammates.admins.teammates[*].email
+}
@@ -6,3 +6,12 @@ data "sendgrid_template" "example" {
 output "name" {
   value = data.sendgrid_template.example.name
 }
+
+# Look up by name instead of id
+data "sendgrid_template" "by_name" {
+  name = "welcome"
+}
+
+output "template_id" {
+  value = data.sendgrid_template.by_name.id
+}
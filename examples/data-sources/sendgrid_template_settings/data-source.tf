@@ -0,0 +1,9 @@
+data "sendgrid_template_settings" "example" {}
+
+output "template_enabled" {
+  value = data.sendgrid_template_settings.example.enabled
+}
+
+output "template_html_content" {
+  value = data.sendgrid_template_settings.example.html_content
+}
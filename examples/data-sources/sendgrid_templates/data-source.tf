@@ -0,0 +1,8 @@
+data "sendgrid_templates" "example" {
+  name_regex = "^welcome-"
+  generation = "dynamic"
+}
+
+output "template_ids" {
+  value = data.sendgrid_templates.example.templates[*].id
+}
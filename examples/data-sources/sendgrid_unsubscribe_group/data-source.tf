@@ -5,3 +5,12 @@ data "sendgrid_unsubscribe_group" "example" {
 output "name" {
   value = data.sendgrid_unsubscribe_group.example.name
 }
+
+# Look up by name instead of id
+data "sendgrid_unsubscribe_group" "by_name" {
+  name = "Newsletter"
+}
+
+output "unsubscribe_group_id" {
+  value = data.sendgrid_unsubscribe_group.by_name.id
+}
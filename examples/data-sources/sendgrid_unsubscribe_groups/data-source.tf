@@ -0,0 +1,7 @@
+data "sendgrid_unsubscribe_groups" "example" {
+  name_regex = "(?i)newsletter"
+}
+
+output "unsubscribe_group_ids" {
+  value = data.sendgrid_unsubscribe_groups.example.unsubscribe_groups[*].id
+}
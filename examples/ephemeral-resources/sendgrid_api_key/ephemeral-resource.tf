@@ -0,0 +1,14 @@
+# A key for the duration of the run, revoked when the run ends.
+ephemeral "sendgrid_api_key" "smoke_test" {
+  name   = "smoke-test"
+  scopes = ["mail.send"]
+}
+
+resource "terraform_data" "smoke_test" {
+  provisioner "local-exec" {
+    command = "./smoke-test.sh"
+    environment = {
+      SENDGRID_API_KEY = ephemeral.sendgrid_api_key.smoke_test.api_key
+    }
+  }
+}
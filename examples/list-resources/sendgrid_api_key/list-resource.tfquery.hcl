@@ -0,0 +1,8 @@
+list "sendgrid_api_key" "ci" {
+  provider         = sendgrid
+  include_resource = true
+
+  config {
+    name_regex = "^ci-"
+  }
+}
@@ -0,0 +1,4 @@
+list "sendgrid_event_webhook" "all" {
+  provider         = sendgrid
+  include_resource = true
+}
@@ -0,0 +1,8 @@
+list "sendgrid_sender_authentication" "example" {
+  provider         = sendgrid
+  include_resource = true
+
+  config {
+    domain = "example.com"
+  }
+}
@@ -0,0 +1,9 @@
+list "sendgrid_subuser" "eu" {
+  provider         = sendgrid
+  include_resource = true
+
+  config {
+    region   = "eu"
+    disabled = false
+  }
+}
@@ -0,0 +1,12 @@
+list "sendgrid_teammate" "all" {
+  provider         = sendgrid
+  include_resource = true
+}
+
+list "sendgrid_teammate" "admins" {
+  provider = sendgrid
+
+  config {
+    user_type = "admin"
+  }
+}
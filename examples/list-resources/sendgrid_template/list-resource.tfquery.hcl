@@ -0,0 +1,19 @@
+# Run `terraform query -generate-config-out=templates.tf` to generate the
+# import blocks and configuration of every dynamic template.
+list "sendgrid_template" "dynamic" {
+  provider         = sendgrid
+  include_resource = true
+
+  config {
+    generation = "dynamic"
+  }
+}
+
+# Templates of a subuser
+list "sendgrid_template" "subuser" {
+  provider = sendgrid
+
+  config {
+    on_behalf_of = "subuser"
+  }
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_address_allow_list_settings.example ""
@@ -0,0 +1,7 @@
+resource "sendgrid_address_allow_list_settings" "example" {
+  enabled = true
+  list = [
+    "example.com",
+    "admin@example.org",
+  ]
+}
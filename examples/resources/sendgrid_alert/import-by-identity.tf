@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_alert.example
+  identity = {
+    id = "123456789"
+  }
+}
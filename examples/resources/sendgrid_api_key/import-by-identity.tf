@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_api_key.example
+  identity = {
+    id = "1234567890AbCdEfGhIjkL"
+  }
+}
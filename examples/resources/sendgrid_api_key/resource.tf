@@ -4,3 +4,33 @@ resource "sendgrid_api_key" "example" {
     "user.profile.read",
   ]
 }
+
+# Rotate the key every 30 days without downtime.
+resource "time_rotating" "sendgrid" {
+  rotation_days = 30
+}
+
+resource "sendgrid_api_key" "app" {
+  name             = "app"
+  scopes           = ["mail.send"]
+  rotation_version = time_rotating.sendgrid.unix
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_secretsmanager_secret_version" "sendgrid" {
+  secret_id                = aws_secretsmanager_secret.sendgrid.id
+  secret_string_wo         = sendgrid_api_key.app.api_key
+  secret_string_wo_version = sendgrid_api_key.app.rotation_version
+}
+
+# Manage a key whose value is never written to the tfstate, e.g. one that is
+# shown once in the SendGrid UI. For a key only needed during a run, use the
+# sendgrid_api_key ephemeral resource instead.
+resource "sendgrid_api_key" "unstored" {
+  name          = "unstored"
+  scopes        = ["mail.send"]
+  store_api_key = false
+}
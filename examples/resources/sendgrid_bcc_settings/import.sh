@@ -0,0 +1 @@
+% terraform import sendgrid_bcc_settings.example ""
@@ -0,0 +1,4 @@
+resource "sendgrid_bcc_settings" "example" {
+  enabled = true
+  email   = "archive@example.com"
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_bypass_bounce_management_settings.example ""
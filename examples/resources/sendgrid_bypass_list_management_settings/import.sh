@@ -0,0 +1 @@
+% terraform import sendgrid_bypass_list_management_settings.example ""
@@ -0,0 +1 @@
+% terraform import sendgrid_bypass_spam_management_settings.example ""
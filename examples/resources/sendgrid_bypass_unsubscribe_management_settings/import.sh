@@ -0,0 +1 @@
+% terraform import sendgrid_bypass_unsubscribe_management_settings.example ""
@@ -0,0 +1,3 @@
+resource "sendgrid_bypass_unsubscribe_management_settings" "example" {
+  enabled = false
+}
@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_design.example
+  identity = {
+    id = "d5c7a2e0-1234-4c3b-9a1e-0123456789ab"
+  }
+}
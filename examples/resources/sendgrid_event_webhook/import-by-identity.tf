@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_event_webhook.example
+  identity = {
+    id = "77d4a5da-7015-11ed-a1eb-0242ac120002"
+  }
+}
@@ -8,3 +8,25 @@ resource "sendgrid_event_webhook" "example" {
   dropped       = true
   friendly_name = "Example Event Webhook"
 }
+
+# The open and unsubscribe events are only sent when open and subscription
+# tracking are enabled, so enable them before the webhook.
+resource "sendgrid_open_tracking_settings" "tracking" {
+  enabled = true
+}
+
+resource "sendgrid_subscription_tracking_settings" "tracking" {
+  enabled = true
+}
+
+resource "sendgrid_event_webhook" "engagement" {
+  url         = "https://example.com/engagement"
+  enabled     = true
+  open        = true
+  unsubscribe = true
+
+  depends_on = [
+    sendgrid_open_tracking_settings.tracking,
+    sendgrid_subscription_tracking_settings.tracking,
+  ]
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_footer_settings.example ""
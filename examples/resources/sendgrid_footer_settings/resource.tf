@@ -0,0 +1,5 @@
+resource "sendgrid_footer_settings" "example" {
+  enabled       = true
+  html_content  = "<p>Example Inc., 1 Main Street, Springfield</p>"
+  plain_content = "Example Inc., 1 Main Street, Springfield"
+}
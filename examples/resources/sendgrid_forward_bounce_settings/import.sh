@@ -0,0 +1 @@
+% terraform import sendgrid_forward_bounce_settings.example ""
@@ -0,0 +1 @@
+% terraform import sendgrid_forward_spam_settings.example ""
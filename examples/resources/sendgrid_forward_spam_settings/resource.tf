@@ -0,0 +1,4 @@
+resource "sendgrid_forward_spam_settings" "example" {
+  enabled = true
+  email   = "abuse@example.com,postmaster@example.com"
+}
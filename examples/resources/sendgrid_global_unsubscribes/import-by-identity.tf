@@ -0,0 +1,15 @@
+import {
+  to = sendgrid_global_unsubscribes.example
+  identity = {
+    emails = ["legal@example.com", "dnc@example.com"]
+  }
+}
+
+# Global unsubscribes of a subuser
+import {
+  to = sendgrid_global_unsubscribes.example
+  identity = {
+    emails       = ["legal@example.com", "dnc@example.com"]
+    on_behalf_of = "subuser"
+  }
+}
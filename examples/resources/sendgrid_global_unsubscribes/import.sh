@@ -0,0 +1,4 @@
+% terraform import sendgrid_global_unsubscribes.example <email>,<email>
+
+# Global unsubscribes of a subuser
+% terraform import sendgrid_global_unsubscribes.example <subuser>/<email>,<email>
@@ -0,0 +1,6 @@
+resource "sendgrid_global_unsubscribes" "do_not_contact" {
+  emails = [
+    "legal@example.com",
+    "do-not-contact@example.com",
+  ]
+}
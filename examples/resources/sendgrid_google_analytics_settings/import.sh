@@ -0,0 +1 @@
+% terraform import sendgrid_google_analytics_settings.example ""
@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_inbound_parse_webhook.example
+  identity = {
+    hostname = "parse.example.com"
+  }
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_ip_access_allow_list.example ""
@@ -0,0 +1,10 @@
+resource "sendgrid_ip_access_allow_list" "example" {
+  ips = [
+    "203.0.113.10",
+    "198.51.100.0/24",
+  ]
+
+  # Only needed when Terraform runs from another address than the last one
+  # that accessed the account, e.g. a CI network.
+  # current_ip = "198.51.100.5"
+}
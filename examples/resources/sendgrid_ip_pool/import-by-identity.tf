@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_ip_pool.example
+  identity = {
+    name = "transactional"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_link_branding.example
+  identity = {
+    id = "123456"
+  }
+}
@@ -2,3 +2,13 @@ resource "sendgrid_link_branding" "example" {
   domain    = "example.com"
   subdomain = "abc"
 }
+
+# Validate the DNS records on apply, checking every 30 seconds for up to
+# 30 minutes. On create, records that are not valid yet are only warned about;
+# use sendgrid_link_branding_validation to wait for them instead.
+resource "sendgrid_link_branding" "validated" {
+  domain                   = "example.org"
+  wait_for_validation      = true
+  validation_timeout       = "30m"
+  validation_poll_interval = "30s"
+}
@@ -0,0 +1,24 @@
+resource "sendgrid_link_branding" "example" {
+  domain    = "example.com"
+  subdomain = "links"
+}
+
+# A branded link has two CNAME records, whose hosts are only known once it is
+# created, so they are counted rather than keyed by host.
+resource "aws_route53_record" "sendgrid" {
+  count = 2
+
+  zone_id = "Z0123456789ABCDEFGHIJ"
+  name    = tolist(sendgrid_link_branding.example.dns)[count.index].host
+  type    = "CNAME"
+  ttl     = 300
+  records = [tolist(sendgrid_link_branding.example.dns)[count.index].data]
+}
+
+# Wait for the DNS records to be valid, in the same apply that creates them.
+resource "sendgrid_link_branding_validation" "example" {
+  link_id            = sendgrid_link_branding.example.id
+  validation_timeout = "30m"
+
+  depends_on = [aws_route53_record.sendgrid]
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_open_tracking_settings.example ""
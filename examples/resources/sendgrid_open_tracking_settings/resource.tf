@@ -0,0 +1,3 @@
+resource "sendgrid_open_tracking_settings" "example" {
+  enabled = true
+}
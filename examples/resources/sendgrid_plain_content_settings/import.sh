@@ -0,0 +1 @@
+% terraform import sendgrid_plain_content_settings.example ""
@@ -0,0 +1,3 @@
+resource "sendgrid_plain_content_settings" "example" {
+  enabled = false
+}
@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_reverse_dns.example
+  identity = {
+    id = "123456"
+  }
+}
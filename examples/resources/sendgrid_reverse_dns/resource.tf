@@ -3,3 +3,14 @@ resource "sendgrid_reverse_dns" "example" {
   domain    = "example.com"
   subdomain = "dummy"
 }
+
+# Validate the A record on apply and wait up to 30 minutes for it to
+# propagate. On create, an A record that is not valid yet is only warned about;
+# use sendgrid_reverse_dns_validation to wait for it instead.
+resource "sendgrid_reverse_dns" "validated" {
+  ip                  = "127.0.0.2"
+  domain              = "example.com"
+  subdomain           = "o2"
+  wait_for_validation = true
+  validation_timeout  = "30m"
+}
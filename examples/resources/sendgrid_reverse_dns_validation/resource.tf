@@ -0,0 +1,21 @@
+resource "sendgrid_reverse_dns" "example" {
+  ip        = "127.0.0.1"
+  domain    = "example.com"
+  subdomain = "o1"
+}
+
+resource "aws_route53_record" "sendgrid" {
+  zone_id = "Z0123456789ABCDEFGHIJ"
+  name    = "o1.example.com"
+  type    = "A"
+  ttl     = 300
+  records = [sendgrid_reverse_dns.example.a_record.data]
+}
+
+# Wait for the A record to be valid, in the same apply that creates it.
+resource "sendgrid_reverse_dns_validation" "example" {
+  reverse_dns_id     = sendgrid_reverse_dns.example.id
+  validation_timeout = "30m"
+
+  depends_on = [aws_route53_record.sendgrid]
+}
@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_sender_authentication.example
+  identity = {
+    id = "12345678"
+  }
+}
@@ -5,3 +5,12 @@ resource "sendgrid_sender_authentication" "example" {
 output "ips" {
   value = sendgrid_sender_authentication.example.ips
 }
+
+# Validate the DNS records on apply and wait up to 30 minutes for them to
+# propagate. On create, records that are not valid yet are only warned about;
+# use sendgrid_sender_authentication_validation to wait for them instead.
+resource "sendgrid_sender_authentication" "validated" {
+  domain              = "example.org"
+  wait_for_validation = true
+  validation_timeout  = "30m"
+}
@@ -0,0 +1,28 @@
+resource "sendgrid_sender_authentication" "example" {
+  domain    = "example.com"
+  subdomain = "em"
+}
+
+# The hosts of the DNS records are known before the domain is created, so they
+# can key the records, which are published once the domain is created.
+locals {
+  sendgrid_hosts = ["em.example.com", "s1._domainkey.example.com", "s2._domainkey.example.com"]
+}
+
+resource "aws_route53_record" "sendgrid" {
+  for_each = toset(local.sendgrid_hosts)
+
+  zone_id = "Z0123456789ABCDEFGHIJ"
+  name    = each.value
+  type    = "CNAME"
+  ttl     = 300
+  records = [one([for r in sendgrid_sender_authentication.example.dns : r.data if r.host == each.value])]
+}
+
+# Wait for the DNS records to be valid, in the same apply that creates them.
+resource "sendgrid_sender_authentication_validation" "example" {
+  domain_id          = sendgrid_sender_authentication.example.id
+  validation_timeout = "30m"
+
+  depends_on = [aws_route53_record.sendgrid]
+}
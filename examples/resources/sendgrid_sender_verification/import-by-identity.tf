@@ -0,0 +1,15 @@
+import {
+  to = sendgrid_sender_verification.example
+  identity = {
+    id = "1234567"
+  }
+}
+
+# Sender of a subuser
+import {
+  to = sendgrid_sender_verification.example
+  identity = {
+    id           = "1234567"
+    on_behalf_of = "subuser"
+  }
+}
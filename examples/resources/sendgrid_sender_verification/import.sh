@@ -1 +1,4 @@
 % terraform import sendgrid_sender_verification.example <id>
+
+# Sender of a subuser
+% terraform import sendgrid_sender_verification.example <subuser>/<id>
@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_sso_certificate.example
+  identity = {
+    id = "123456"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_sso_integration.example
+  identity = {
+    id = "b0b98502-9408-4b24-9e3d-31ed7cb15312"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_sso_teammate.example
+  identity = {
+    email = "teammate@example.com"
+  }
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_subscription_tracking_settings.example ""
@@ -0,0 +1,6 @@
+resource "sendgrid_subscription_tracking_settings" "example" {
+  enabled       = true
+  html_content  = "<p>If you would like to unsubscribe and stop receiving these emails <% click here %>.</p>"
+  plain_content = "If you would like to unsubscribe and stop receiving these emails click here: <% %>."
+  url           = "https://example.com/unsubscribed"
+}
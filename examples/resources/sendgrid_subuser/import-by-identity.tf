@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_subuser.example
+  identity = {
+    username = "subuser"
+  }
+}
@@ -5,3 +5,14 @@ resource "sendgrid_subuser" "example" {
   password_wo_version = 1
   ips                 = ["1.1.1.2"]
 }
+
+# Pause a subuser during an incident without deleting it
+resource "sendgrid_subuser" "paused" {
+  username            = "dummy-marketing"
+  email               = "dummy-marketing@example.com"
+  password_wo         = "dummydummy1!"
+  password_wo_version = 1
+  ips                 = ["1.1.1.2"]
+  disabled            = true
+  website_access      = false
+}
@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_subuser_credits.example
+  identity = {
+    username = "subuser"
+  }
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_subuser_credits.example <subuser's name>
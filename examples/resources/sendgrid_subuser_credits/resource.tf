@@ -0,0 +1,12 @@
+resource "sendgrid_subuser_credits" "marketing" {
+  username        = sendgrid_subuser.marketing.username
+  type            = "recurring"
+  total           = 100000
+  reset_frequency = "monthly"
+}
+
+resource "sendgrid_subuser_credits" "campaign" {
+  username = sendgrid_subuser.campaign.username
+  type     = "nonrecurring"
+  total    = 5000
+}
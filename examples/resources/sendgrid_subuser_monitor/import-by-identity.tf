@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_subuser_monitor.example
+  identity = {
+    username = "subuser"
+  }
+}
@@ -0,0 +1 @@
+% terraform import sendgrid_subuser_monitor.example <subuser's name>
@@ -0,0 +1,5 @@
+resource "sendgrid_subuser_monitor" "example" {
+  username  = sendgrid_subuser.example.username
+  email     = "compliance@example.com"
+  frequency = 500
+}
@@ -0,0 +1,16 @@
+# Import the domain associated with the subuser
+import {
+  to = sendgrid_subuser_whitelabel_domain.example
+  identity = {
+    subuser = "example-subuser"
+  }
+}
+
+# Import a given domain
+import {
+  to = sendgrid_subuser_whitelabel_domain.example
+  identity = {
+    domain_id = 12345
+    subuser   = "example-subuser"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = sendgrid_teammate.example
+  identity = {
+    email = "teammate@example.com"
+  }
+}
@@ -14,3 +14,10 @@ resource "sendgrid_teammate" "example" {
     "user.username.read",
   ]
 }
+
+# Resend the invitation, e.g. after it expired, by changing the trigger.
+resource "sendgrid_teammate" "new_hire" {
+  email                     = "new-hire@example.com"
+  scopes                    = ["user.profile.read"]
+  resend_invitation_trigger = "2"
+}
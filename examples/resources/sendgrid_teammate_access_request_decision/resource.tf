@@ -0,0 +1,20 @@
+# Approve a request reviewed in a pull request.
+resource "sendgrid_teammate_access_request_decision" "example" {
+  request_id = 12345
+  decision   = "approve"
+}
+
+# Approve the open requests for groups of scopes within policy, and deny the others.
+# Decided requests are no longer listed, so their decisions leave the state on the
+# next apply, without being undone.
+data "sendgrid_teammate_access_requests" "all" {}
+
+resource "sendgrid_teammate_access_request_decision" "policy" {
+  for_each = {
+    for r in data.sendgrid_teammate_access_requests.all.access_requests : tostring(r.id) => r
+  }
+
+  request_id           = each.value.id
+  decision             = "approve"
+  allowed_scope_groups = ["Mail Send", "Stats"]
+}
@@ -0,0 +1,15 @@
+import {
+  to = sendgrid_template.example
+  identity = {
+    id = "d-1234567890abcdef1234567890abcdef"
+  }
+}
+
+# Template of a subuser
+import {
+  to = sendgrid_template.example
+  identity = {
+    id           = "d-1234567890abcdef1234567890abcdef"
+    on_behalf_of = "subuser"
+  }
+}
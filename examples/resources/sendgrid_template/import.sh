@@ -1 +1,4 @@
 % terraform import sendgrid_template.example <template id>
+
+# Template of a subuser
+% terraform import sendgrid_template.example <subuser>/<template id>
@@ -0,0 +1 @@
+% terraform import sendgrid_template_settings.example ""
@@ -0,0 +1,4 @@
+resource "sendgrid_template_settings" "example" {
+  enabled      = true
+  html_content = "<html><body><% body %></body></html>"
+}
@@ -0,0 +1,17 @@
+import {
+  to = sendgrid_template_version.example
+  identity = {
+    template_id = "d-1234567890abcdef1234567890abcdef"
+    id          = "12345678-90ab-cdef-1234-567890abcdef"
+  }
+}
+
+# Template version of a subuser
+import {
+  to = sendgrid_template_version.example
+  identity = {
+    template_id  = "d-1234567890abcdef1234567890abcdef"
+    id           = "12345678-90ab-cdef-1234-567890abcdef"
+    on_behalf_of = "subuser"
+  }
+}
@@ -1 +1,4 @@
 % terraform import sendgrid_template_version.example <template id>/<template version id>
+
+# Template version of a subuser
+% terraform import sendgrid_template_version.example <subuser>/<template id>/<template version id>
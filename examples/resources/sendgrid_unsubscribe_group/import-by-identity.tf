@@ -0,0 +1,15 @@
+import {
+  to = sendgrid_unsubscribe_group.example
+  identity = {
+    id = "12345"
+  }
+}
+
+# Unsubscribe group of a subuser
+import {
+  to = sendgrid_unsubscribe_group.example
+  identity = {
+    id           = "12345"
+    on_behalf_of = "subuser"
+  }
+}
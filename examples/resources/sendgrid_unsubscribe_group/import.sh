@@ -1 +1,4 @@
 % terraform import sendgrid_unsubscribe_group.example <unsubscribe group id>
+
+# Unsubscribe group of a subuser
+% terraform import sendgrid_unsubscribe_group.example <subuser>/<unsubscribe group id>
@@ -0,0 +1,15 @@
+import {
+  to = sendgrid_unsubscribe_group_suppressions.example
+  identity = {
+    group_id = "12345"
+  }
+}
+
+# Suppressions of an unsubscribe group of a subuser
+import {
+  to = sendgrid_unsubscribe_group_suppressions.example
+  identity = {
+    group_id     = "12345"
+    on_behalf_of = "subuser"
+  }
+}
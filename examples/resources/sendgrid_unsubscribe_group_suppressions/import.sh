@@ -0,0 +1,4 @@
+% terraform import sendgrid_unsubscribe_group_suppressions.example <unsubscribe group id>
+
+# Suppressions of an unsubscribe group of a subuser
+% terraform import sendgrid_unsubscribe_group_suppressions.example <subuser>/<unsubscribe group id>
@@ -0,0 +1,12 @@
+resource "sendgrid_unsubscribe_group" "newsletter" {
+  name        = "Newsletter"
+  description = "Our monthly newsletter"
+}
+
+resource "sendgrid_unsubscribe_group_suppressions" "newsletter" {
+  group_id = sendgrid_unsubscribe_group.newsletter.id
+  emails = [
+    "legal@example.com",
+    "do-not-contact@example.com",
+  ]
+}
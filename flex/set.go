@@ -3,6 +3,7 @@ package flex
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -70,3 +71,31 @@ func DiffStringList(ctx context.Context, oldList, newList types.List) (added, re
 
 	return added, removed, nil
 }
+
+// DiffStringSet returns the values of newSet that are not in oldSet, and the
+// values of oldSet that are not in newSet, both sorted.
+func DiffStringSet(ctx context.Context, oldSet, newSet types.Set) (added, removed []string, err error) {
+	var oldValues, newValues []string
+
+	if diags := oldSet.ElementsAs(ctx, &oldValues, false); diags.HasError() {
+		return nil, nil, fmt.Errorf("failed to read old set")
+	}
+	if diags := newSet.ElementsAs(ctx, &newValues, false); diags.HasError() {
+		return nil, nil, fmt.Errorf("failed to read new set")
+	}
+
+	for _, v := range newValues {
+		if !slices.Contains(oldValues, v) {
+			added = append(added, v)
+		}
+	}
+	for _, v := range oldValues {
+		if !slices.Contains(newValues, v) {
+			removed = append(removed, v)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+
+	return added, removed, nil
+}
@@ -10,6 +10,7 @@ require (
 	github.com/hashicorp/terraform-plugin-log v0.10.0
 	github.com/hashicorp/terraform-plugin-testing v1.16.0
 	github.com/kenzo0107/sendgrid v1.13.0
+	golang.org/x/time v0.15.0
 )
 
 require (
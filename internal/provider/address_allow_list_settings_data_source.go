@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &addressAllowListSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &addressAllowListSettingsDataSource{}
+)
+
+func newAddressAllowListSettingsDataSource() datasource.DataSource {
+	return &addressAllowListSettingsDataSource{}
+}
+
+type addressAllowListSettingsDataSource struct {
+	client *sendgrid.Client
+}
+
+type addressAllowListSettingsDataSourceModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+	List    types.Set  `tfsdk:"list"`
+}
+
+func (d *addressAllowListSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_address_allow_list_settings"
+}
+
+func (d *addressAllowListSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *addressAllowListSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Retrieve the address allow list mail setting of your SendGrid account.
+
+The Address Allow List setting allows you to specify email addresses or domains for which mail should never be suppressed.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the address allow list mail setting is enabled.",
+				Computed:            true,
+			},
+			"list": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses and domains for which mail should never be suppressed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *addressAllowListSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state addressAllowListSettingsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getMailSetting[mailSettingAddressWhitelist](ctx, d.client, addressAllowListSetting)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading address allow list settings",
+			fmt.Sprintf("Unable to get address allow list settings, got error: %s", err),
+		)
+		return
+	}
+
+	list, diags := types.SetValueFrom(ctx, types.StringType, o.List)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	u := addressAllowListSettingsDataSourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		List:    list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &u)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &addressAllowListSettingsResource{}
+var _ resource.ResourceWithImportState = &addressAllowListSettingsResource{}
+
+// The address allow list is still called address_whitelist in the API.
+const addressAllowListSetting = "address_whitelist"
+
+func newAddressAllowListSettingsResource() resource.Resource {
+	return &addressAllowListSettingsResource{}
+}
+
+type addressAllowListSettingsResource struct {
+	client *sendgrid.Client
+}
+
+type addressAllowListSettingsResourceModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+	List    types.Set  `tfsdk:"list"`
+}
+
+func (r *addressAllowListSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_address_allow_list_settings"
+}
+
+func (r *addressAllowListSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the address allow list mail setting of your SendGrid account.
+
+The Address Allow List setting allows you to specify email addresses or domains for which mail should never be suppressed. For example, if you own the domain example.com, and one or more of your recipients use email@example.com addresses, placing example.com in the address allow list setting instructs SendGrid to ignore all bounces, blocks, and unsubscribes logged for that domain. In other words, all bounces, blocks, and unsubscribes will still be sent to example.com as if they were sent under normal sending conditions.
+
+Destroying this resource disables the setting and empties the list.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the address allow list mail setting is enabled.",
+				Required:            true,
+			},
+			"list": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses and domains for which mail should never be suppressed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *addressAllowListSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *addressAllowListSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan addressAllowListSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := updateMailSetting(ctx, r.client, addressAllowListSetting, &mailSettingAddressWhitelist{
+		Enabled: plan.Enabled.ValueBool(),
+		List:    expandAddressAllowList(ctx, plan.List),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating address allow list settings",
+			fmt.Sprintf("Unable to update address allow list settings, got error: %s", err),
+		)
+		return
+	}
+
+	list, d := types.SetValueFrom(ctx, types.StringType, o.List)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan = addressAllowListSettingsResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		List:    list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *addressAllowListSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state addressAllowListSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getMailSetting[mailSettingAddressWhitelist](ctx, r.client, addressAllowListSetting)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading address allow list settings",
+			fmt.Sprintf("Unable to read address allow list settings, got error: %s", err),
+		)
+		return
+	}
+
+	list, d := types.SetValueFrom(ctx, types.StringType, o.List)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state = addressAllowListSettingsResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		List:    list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *addressAllowListSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data addressAllowListSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := updateMailSetting(ctx, r.client, addressAllowListSetting, &mailSettingAddressWhitelist{
+		Enabled: data.Enabled.ValueBool(),
+		List:    expandAddressAllowList(ctx, data.List),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating address allow list settings",
+			fmt.Sprintf("Unable to update address allow list settings, got error: %s", err),
+		)
+		return
+	}
+
+	list, d := types.SetValueFrom(ctx, types.StringType, o.List)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = addressAllowListSettingsResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		List:    list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *addressAllowListSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state addressAllowListSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mail settings cannot be deleted, so reset the setting to its default.
+	_, err := updateMailSetting(ctx, r.client, addressAllowListSetting, &mailSettingAddressWhitelist{
+		Enabled: false,
+		List:    []string{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting address allow list settings",
+			fmt.Sprintf("Unable to reset address allow list settings, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *addressAllowListSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data addressAllowListSettingsResourceModel
+
+	o, err := getMailSetting[mailSettingAddressWhitelist](ctx, r.client, addressAllowListSetting)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing address allow list settings",
+			fmt.Sprintf("Unable to read address allow list settings, got error: %s", err),
+		)
+		return
+	}
+
+	list, d := types.SetValueFrom(ctx, types.StringType, o.List)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = addressAllowListSettingsResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		List:    list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// expandAddressAllowList always returns a non-nil slice, so that an empty set
+// clears the list on SendGrid.
+func expandAddressAllowList(ctx context.Context, set types.Set) []string {
+	list := flex.ExpandFrameworkStringSet(ctx, set)
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
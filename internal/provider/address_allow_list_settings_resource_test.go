@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAddressAllowListSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_address_allow_list_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAddressAllowListSettingsResourceConfig(true, `["example.com", "test@example.org"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list.*", "example.com"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list.*", "test@example.org"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "enabled",
+			},
+			// Update and Read testing
+			{
+				Config: testAccAddressAllowListSettingsResourceConfig(false, `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddressAllowListSettingsResourceConfig(enabled bool, list string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_address_allow_list_settings" "test" {
+  enabled = %t
+  list    = %s
+}`, enabled, list)
+}
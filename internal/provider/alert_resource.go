@@ -8,8 +8,8 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &alertResource{}
 var _ resource.ResourceWithImportState = &alertResource{}
+var _ resource.ResourceWithIdentity = &alertResource{}
 
 func newAlertResource() resource.Resource {
 	return &alertResource{}
@@ -39,6 +40,16 @@ type alertResourceModel struct {
 	Percentage types.Int64  `tfsdk:"percentage"`
 }
 
+type alertIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (m alertResourceModel) identity() alertIdentityModel {
+	return alertIdentityModel{
+		ID: m.ID,
+	}
+}
+
 func (r *alertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_alert"
 }
@@ -85,6 +96,17 @@ Twilio SendGrid's Alerts feature allows you to receive notifications regarding y
 	}
 }
 
+func (r *alertResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the alert.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *alertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -120,14 +142,11 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateAlert(ctx, &sendgrid.InputCreateAlert{
-			EmailTo:    plan.EmailTo.ValueString(),
-			Type:       plan.Type.ValueString(),
-			Frequency:  plan.Frequency.ValueString(),
-			Percentage: plan.Percentage.ValueInt64(),
-		})
+	o, err := r.client.CreateAlert(ctx, &sendgrid.InputCreateAlert{
+		EmailTo:    plan.EmailTo.ValueString(),
+		Type:       plan.Type.ValueString(),
+		Frequency:  plan.Frequency.ValueString(),
+		Percentage: plan.Percentage.ValueInt64(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -137,15 +156,6 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateAlert)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating alert",
-			"Failed to assert type *sendgrid.OutputCreateAlert",
-		)
-		return
-	}
-
 	plan = alertResourceModel{
 		ID:         types.StringValue(strconv.FormatInt(o.ID, 10)),
 		EmailTo:    types.StringValue(o.EmailTo),
@@ -154,6 +164,7 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 		Percentage: types.Int64Value(o.Percentage),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -198,6 +209,7 @@ func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.Percentage = types.Int64Value(o.Percentage)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -243,6 +255,7 @@ func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -265,10 +278,7 @@ func (r *alertResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	_, err = retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteAlert(ctx, idInt64)
-	})
+	err = r.client.DeleteAlert(ctx, idInt64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting alert",
@@ -281,7 +291,11 @@ func (r *alertResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *alertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data alertResourceModel
 
-	id := req.ID
+	id := importIDOrIdentity(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	idInt64, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -291,8 +305,6 @@ func (r *alertResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-
 	o, err := r.client.GetAlert(ctx, idInt64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -310,6 +322,7 @@ func (r *alertResource) ImportState(ctx context.Context, req resource.ImportStat
 		Percentage: types.Int64Value(o.Percentage),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
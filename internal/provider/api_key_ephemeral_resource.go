@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &apiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &apiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &apiKeyEphemeralResource{}
+
+// apiKeyPrivateKey is the key of the private data the ID of the API key is
+// kept in between Open and Close.
+const apiKeyPrivateKey = "api_key_id"
+
+func newAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &apiKeyEphemeralResource{}
+}
+
+type apiKeyEphemeralResource struct {
+	client *sendgrid.Client
+}
+
+type apiKeyEphemeralResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Scopes types.Set    `tfsdk:"scopes"`
+	APIKey types.String `tfsdk:"api_key"`
+}
+
+func (r *apiKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *apiKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides a short-lived API Key, which is created when Terraform opens it and revoked when Terraform closes it at the end of the run.
+
+Unlike the ` + "`sendgrid_api_key`" + ` resource, the API key is never stored in the state or plan. It can be passed to provider configurations, provisioners and write-only attributes of other providers. A new API key is created on every run, and as it is revoked when the run ends, it only suits what uses it during the run: a copy written to a secrets manager stops working once the run ends.
+
+For more detailed information, please see the [SendGrid documentation](https://docs.sendgrid.com/ui/account-and-settings/api-keys).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of API Key",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of API Key",
+				Required:            true,
+			},
+			"scopes": schema.SetAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: `The permissions API Key has access to.
+
+The following Scopes are set automatically by SendGrid, so they cannot be set manually:
+
+- ` + strings.Join(defaultScopes, "\n- ") + `
+
+Only the scopes the API key of the provider has can be granted, which are listed by the ` + "`sendgrid_scopes`" + ` data source. Other scopes fail the plan.
+`,
+				Optional: true,
+				Computed: true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API Key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *apiKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *apiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopes := flex.ExpandFrameworkStringSet(ctx, data.Scopes)
+	if err := validateScopes(scopes); err != nil {
+		resp.Diagnostics.AddError(
+			"Opening api key",
+			fmt.Sprintf("Unable to create api key, got error: %s", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(validateGrantableScopes(ctx, r.client, path.Root("scopes"), scopes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.CreateAPIKey(ctx, &sendgrid.InputCreateAPIKey{
+		Name:   data.Name.ValueString(),
+		Scopes: scopes,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Opening api key",
+			fmt.Sprintf("Unable to create api key, got error: %s", err),
+		)
+		return
+	}
+
+	// Keep the ID before anything else can fail, so that Close revokes the
+	// key.
+	id, err := json.Marshal(o.ApiKeyId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Opening api key",
+			fmt.Sprintf("Unable to encode api key ID, got error: %s", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyPrivateKey, id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopesSet, d := types.SetValueFrom(ctx, types.StringType, excludeDefaultScopes(o.Scopes))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = apiKeyEphemeralResourceModel{
+		ID:     types.StringValue(o.ApiKeyId),
+		Name:   types.StringValue(o.Name),
+		Scopes: scopesSet,
+		APIKey: types.StringValue(o.ApiKey),
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *apiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	b, d := req.Private.GetKey(ctx, apiKeyPrivateKey)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || b == nil {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(b, &id); err != nil {
+		resp.Diagnostics.AddError(
+			"Closing api key",
+			fmt.Sprintf("Unable to decode api key ID, got error: %s", err),
+		)
+		return
+	}
+
+	if err := r.client.DeleteAPIKey(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Closing api key",
+			fmt.Sprintf("Unable to revoke api key (id: %s), got error: %s", id, err),
+		)
+		return
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/kenzo0107/sendgrid"
+)
+
+func TestAccAPIKeyEphemeralResource(t *testing.T) {
+	server := testAccOffline(t)
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"sendgrid": testAccProtoV6ProviderFactories["sendgrid"],
+			"echo":     echoprovider.NewProviderServer(),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyEphemeralResourceConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("scopes"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("mail.send"),
+					})),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("api_key"), knownvalue.NotNull()),
+				},
+				// The keys created during the run are revoked when it ends.
+				Check: func(s *terraform.State) error {
+					client := sendgrid.New("SG.offline", sendgrid.OptionBaseURL(server.BaseURL()))
+					o, err := client.GetAPIKeys(context.Background())
+					if err != nil {
+						return err
+					}
+					for _, k := range o.APIKeys {
+						if k.Name == name {
+							return fmt.Errorf("api key %s was not revoked", k.ApiKeyId)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccAPIKeyEphemeralResourceConfig(name string) string {
+	return fmt.Sprintf(`
+ephemeral "sendgrid_api_key" "test" {
+	name   = "%s"
+	scopes = ["mail.send"]
+}
+
+provider "echo" {
+	data = ephemeral.sendgrid_api_key.test
+}
+
+resource "echo" "test" {}
+`, name)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResourceWithConfigure = &apiKeyListResource{}
+
+func newAPIKeyListResource() list.ListResource {
+	return &apiKeyListResource{}
+}
+
+type apiKeyListResource struct {
+	apiKeyResource
+}
+
+type apiKeyListResourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (r *apiKeyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the API keys of your SendGrid account. The key values themselves cannot be read back from SendGrid, so `api_key` is never set.",
+		Attributes: map[string]listschema.Attribute{
+			"name_regex": listRegexFilterAttribute("API keys", "name"),
+		},
+	}
+}
+
+func (r *apiKeyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data apiKeyListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	nameRegex, err := newRegexFilter(data.NameRegex)
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing api keys",
+			fmt.Sprintf("Unable to compile name_regex, got error: %s", err),
+		)
+		return
+	}
+
+	keys, err := listAllAPIKeys(ctx, r.client)
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing api keys",
+			fmt.Sprintf("Unable to list api keys, got error: %s", err),
+		)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		push = limitResults(req, push)
+		for _, v := range keys {
+			if !nameRegex.match(v.Name) {
+				continue
+			}
+
+			m := apiKeyResourceModel{
+				ID:   types.StringValue(v.ApiKeyId),
+				Name: types.StringValue(v.Name),
+			}
+			// The scopes of an API key are only returned when it is read on
+			// its own, so it is only read when the query needs them.
+			if req.IncludeResource {
+				key, err := r.client.GetAPIKey(ctx, v.ApiKeyId)
+				if err != nil {
+					push(listErrorResult(
+						"Listing api keys",
+						fmt.Sprintf("Unable to read api key (id: %s), got error: %s", v.ApiKeyId, err),
+					))
+					return
+				}
+				var diags diag.Diagnostics
+				if m, diags = apiKeyImportModelFrom(ctx, key); diags.HasError() {
+					push(list.ListResult{Diagnostics: diags})
+					return
+				}
+			}
+			if !push(newListResult(ctx, req, v.Name, m.identity(), &m)) {
+				return
+			}
+		}
+	}
+}
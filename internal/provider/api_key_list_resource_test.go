@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccAPIKeyListResource(t *testing.T) {
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyResourceConfig(name),
+			},
+			// ImportState by identity testing
+			{
+				ResourceName:    "sendgrid_api_key.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				Query:  true,
+				Config: testAccAPIKeyListResourceQuery(name),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("sendgrid_api_key.test", 1),
+					querycheck.ExpectIdentity("sendgrid_api_key.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					querycheck.ExpectResourceKnownValues("sendgrid_api_key.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(name)),
+						[]querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("scopes"), KnownValue: knownvalue.SetExact([]knownvalue.Check{
+								knownvalue.StringExact("user.profile.read"),
+							})},
+							{Path: tfjsonpath.New("api_key"), KnownValue: knownvalue.Null()},
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccAPIKeyListResourceQuery(name string) string {
+	return fmt.Sprintf(`
+list "sendgrid_api_key" "test" {
+	provider         = sendgrid
+	include_resource = true
+
+	config {
+		name_regex = %q
+	}
+}
+`, "^"+regexp.QuoteMeta(name)+"$")
+}
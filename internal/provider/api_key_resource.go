@@ -10,9 +10,13 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
 	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
@@ -21,6 +25,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &apiKeyResource{}
 var _ resource.ResourceWithImportState = &apiKeyResource{}
+var _ resource.ResourceWithIdentity = &apiKeyResource{}
+var _ resource.ResourceWithModifyPlan = &apiKeyResource{}
 
 func newAPIKeyResource() resource.Resource {
 	return &apiKeyResource{}
@@ -31,10 +37,22 @@ type apiKeyResource struct {
 }
 
 type apiKeyResourceModel struct {
-	ID     types.String `tfsdk:"id"`
-	Name   types.String `tfsdk:"name"`
-	Scopes types.Set    `tfsdk:"scopes"`
-	APIKey types.String `tfsdk:"api_key"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Scopes          types.Set    `tfsdk:"scopes"`
+	APIKey          types.String `tfsdk:"api_key"`
+	StoreAPIKey     types.Bool   `tfsdk:"store_api_key"`
+	RotationVersion types.Int64  `tfsdk:"rotation_version"`
+}
+
+type apiKeyIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (m apiKeyResourceModel) identity() apiKeyIdentityModel {
+	return apiKeyIdentityModel{
+		ID: m.ID,
+	}
 }
 
 var defaultScopes = []string{
@@ -54,6 +72,17 @@ func excludeDefaultScopes(scopes []string) []string {
 	return filteredScopes
 }
 
+// validateScopes fails when scopes include the ones SendGrid adds
+// automatically, as they cannot be assigned manually.
+func validateScopes(scopes []string) error {
+	for _, s := range scopes {
+		if slices.Contains(defaultScopes, s) {
+			return fmt.Errorf("scopes automatically by SendGrid and cannot be manually assigned: %s", strings.Join(defaultScopes, ", "))
+		}
+	}
+	return nil
+}
+
 func (r *apiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_api_key"
 }
@@ -83,14 +112,40 @@ For more detailed information, please see the [SendGrid documentation](https://d
 The following Scopes are set automatically by SendGrid, so they cannot be set manually:
 
 - ` + strings.Join(defaultScopes, "\n- ") + `
+
+Only the scopes the API key of the provider has can be granted, which are listed by the ` + "`sendgrid_scopes`" + ` data source. Other scopes fail the plan. To start from a persona such as ` + "`developer`" + ` or ` + "`marketer`" + `, use the ` + "`sendgrid_scope_preset`" + ` data source.
 `,
 				Optional: true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API Key. NOTE: If imported, you cannot set the value of the API key. This is because the API key is issued only during the creation process.",
+				MarkdownDescription: "API Key. NOTE: If imported, you cannot set the value of the API key. This is because the API key is issued only during the creation process. It is null when `store_api_key` is `false`.",
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"store_api_key": schema.BoolAttribute{
+				MarkdownDescription: "Whether to keep `api_key` in the tfstate. When `false`, the key is managed without its value ever being written to the tfstate, so `api_key` is null and cannot be passed to other resources. To use a key during a run without storing it, use the `sendgrid_api_key` ephemeral resource instead. Setting it back to `true` does not restore the key. (Default: `true`)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"rotation_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of the API key. Change this value to rotate the API key: a new key replaces the old one, which is deleted. Set `create_before_destroy` in the `lifecycle` block so that the resources using the key are updated before the old key is deleted. After `terraform import`, the state value is null; specifying a value in config will be absorbed into state on the next apply without rotating the key.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					requiresReplaceIfStateNotNullInt64(),
+				},
+			},
+		},
+	}
+}
+
+func (r *apiKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the API key.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -114,6 +169,24 @@ func (r *apiKeyResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	scopes, d := changedScopes(ctx, req)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateScopes(scopes); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("scopes"), "Invalid scope", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(validateGrantableScopes(ctx, r.client, path.Root("scopes"), scopes)...)
+}
+
 func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan apiKeyResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -123,40 +196,22 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	scopes := flex.ExpandFrameworkStringSet(ctx, plan.Scopes)
 
-	for _, s := range scopes {
-		if slices.Contains(defaultScopes, s) {
-			// If scopes automatically added by SendGrid is specified, the process should fail.
-			resp.Diagnostics.AddError(
-				"Creating API Key",
-				fmt.Sprintf(
-					"Unable to create API Key, got error: scopes automatically by SendGrid and cannot be manually assigned: %s",
-					strings.Join(defaultScopes, ", "),
-				),
-			)
-			return
-		}
-	}
-
-	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateAPIKey(ctx, &sendgrid.InputCreateAPIKey{
-			Name:   plan.Name.ValueString(),
-			Scopes: scopes,
-		})
-	})
-	if err != nil {
+	if err := validateScopes(scopes); err != nil {
 		resp.Diagnostics.AddError(
-			"Creating api key",
-			fmt.Sprintf("Unable to create api key, got error: %s", err),
+			"Creating API Key",
+			fmt.Sprintf("Unable to create API Key, got error: %s", err),
 		)
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateAPIKey)
-	if !ok {
+	o, err := r.client.CreateAPIKey(ctx, &sendgrid.InputCreateAPIKey{
+		Name:   plan.Name.ValueString(),
+		Scopes: scopes,
+	})
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating api key",
-			"Failed to assert type *sendgrid.OutputCreateAPIKey",
+			fmt.Sprintf("Unable to create api key, got error: %s", err),
 		)
 		return
 	}
@@ -168,12 +223,18 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	plan = apiKeyResourceModel{
-		ID:     types.StringValue(o.ApiKeyId),
-		Name:   types.StringValue(o.Name),
-		Scopes: scopesSet,
-		APIKey: types.StringValue(o.ApiKey),
+		ID:              types.StringValue(o.ApiKeyId),
+		Name:            types.StringValue(o.Name),
+		Scopes:          scopesSet,
+		APIKey:          types.StringValue(o.ApiKey),
+		StoreAPIKey:     plan.StoreAPIKey,
+		RotationVersion: plan.RotationVersion,
+	}
+	if !plan.StoreAPIKey.ValueBool() {
+		plan.APIKey = types.StringNull()
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -211,7 +272,11 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	state.ID = types.StringValue(o.ApiKeyId)
 	state.Name = types.StringValue(o.Name)
 	state.Scopes = scopes
+	if !state.StoreAPIKey.ValueBool() {
+		state.APIKey = types.StringNull()
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -227,19 +292,12 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	id := state.ID.ValueString()
 
-	dataScopes := flex.ExpandFrameworkStringSet(ctx, data.Scopes)
-	for _, s := range dataScopes {
-		if slices.Contains(defaultScopes, s) {
-			// If scopes automatically added by SendGrid is specified, the process should fail.
-			resp.Diagnostics.AddError(
-				"Updateting API Key",
-				fmt.Sprintf(
-					"Unable to update API Key, got error: scopes automatically by SendGrid and cannot be manually assigned: %s",
-					strings.Join(defaultScopes, ", "),
-				),
-			)
-			return
-		}
+	if err := validateScopes(flex.ExpandFrameworkStringSet(ctx, data.Scopes)); err != nil {
+		resp.Diagnostics.AddError(
+			"Updating API Key",
+			fmt.Sprintf("Unable to update API Key, got error: %s", err),
+		)
+		return
 	}
 
 	var scopes []string
@@ -251,6 +309,9 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	data.ID = types.StringValue(id)
 	data.APIKey = state.APIKey
+	if !data.StoreAPIKey.ValueBool() {
+		data.APIKey = types.StringNull()
+	}
 
 	if len(scopes) > 0 {
 		// update name and scopes
@@ -286,6 +347,7 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -300,10 +362,7 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	id := state.ID.ValueString()
 
-	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteAPIKey(ctx, id)
-	})
+	err := r.client.DeleteAPIKey(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting api key",
@@ -314,11 +373,10 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var data apiKeyResourceModel
-
-	id := req.ID
-
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importIDOrIdentity(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	o, err := r.client.GetAPIKey(ctx, id)
 	if err != nil {
@@ -329,20 +387,32 @@ func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportSta
 		return
 	}
 
-	scopes, d := types.SetValueFrom(ctx, types.StringType, excludeDefaultScopes(o.Scopes))
+	data, d := apiKeyImportModelFrom(ctx, o)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// NOTE: cannot set ApiKey because sendgrid api cannot get api key
-	data = apiKeyResourceModel{
-		ID:     types.StringValue(o.ApiKeyId),
-		Name:   types.StringValue(o.Name),
-		Scopes: scopes,
-	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+// apiKeyImportModelFrom converts an API key read from SendGrid to the
+// resource model of an imported API key.
+func apiKeyImportModelFrom(ctx context.Context, o *sendgrid.OutputGetAPIKey) (apiKeyResourceModel, diag.Diagnostics) {
+	scopes, d := types.SetValueFrom(ctx, types.StringType, excludeDefaultScopes(o.Scopes))
+	if d.HasError() {
+		return apiKeyResourceModel{}, d
+	}
+
+	// NOTE: cannot set ApiKey because sendgrid api cannot get api key
+	return apiKeyResourceModel{
+		ID:          types.StringValue(o.ApiKeyId),
+		Name:        types.StringValue(o.Name),
+		Scopes:      scopes,
+		StoreAPIKey: types.BoolValue(true),
+	}, d
+}
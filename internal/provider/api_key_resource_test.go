@@ -5,10 +5,16 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccAPIKeyResource(t *testing.T) {
@@ -62,3 +68,130 @@ resource "sendgrid_api_key" "test" {
 }
 `, name)
 }
+
+func TestAccAPIKeyResource_storeAPIKey(t *testing.T) {
+	resourceName := "sendgrid_api_key.test"
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The key is never written to the tfstate,
+			{
+				Config: testAccAPIKeyResourceConfigStoreAPIKey(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "store_api_key", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "api_key"),
+				),
+			},
+			// including on refresh.
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "api_key"),
+				),
+			},
+			{
+				Config:   testAccAPIKeyResourceConfigStoreAPIKey(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccAPIKeyResourceConfigStoreAPIKey(name string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_api_key" "test" {
+	name          = "%s"
+	store_api_key = false
+	scopes = [
+		"user.profile.read",
+	]
+}
+`, name)
+}
+
+func TestAccAPIKeyResource_rotation(t *testing.T) {
+	resourceName := "sendgrid_api_key.test"
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	ids := statecheck.CompareValue(compare.ValuesDiffer())
+	keys := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyResourceConfigRotation(name, 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					ids.AddStateValue(resourceName, tfjsonpath.New("id")),
+					keys.AddStateValue(resourceName, tfjsonpath.New("api_key")),
+				},
+			},
+			// Changing rotation_version creates a new key before deleting the
+			// old one.
+			{
+				Config: testAccAPIKeyResourceConfigRotation(name, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					ids.AddStateValue(resourceName, tfjsonpath.New("id")),
+					keys.AddStateValue(resourceName, tfjsonpath.New("api_key")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("rotation_version"), knownvalue.Int64Exact(2)),
+				},
+			},
+		},
+	})
+}
+
+func testAccAPIKeyResourceConfigRotation(name string, version int) string {
+	return fmt.Sprintf(`
+resource "sendgrid_api_key" "test" {
+	name             = "%s"
+	rotation_version = %d
+	scopes = [
+		"user.profile.read",
+	]
+
+	lifecycle {
+		create_before_destroy = true
+	}
+}
+`, name, version)
+}
+
+func TestAccAPIKeyResource_invalidScope(t *testing.T) {
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "sendgrid_api_key" "test" {
+	name   = "%s"
+	scopes = ["mail.sned"]
+}
+`, name),
+				ExpectError: regexp.MustCompile(`Did\s+you\s+mean\s+"mail\.send"\?`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "sendgrid_api_key" "test" {
+	name   = "%s"
+	scopes = ["2fa_required"]
+}
+`, name),
+				ExpectError: regexp.MustCompile(`cannot\s+be\s+manually\s+assigned`),
+			},
+		},
+	})
+}
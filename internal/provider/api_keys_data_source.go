@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &apiKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &apiKeysDataSource{}
+)
+
+func newAPIKeysDataSource() datasource.DataSource {
+	return &apiKeysDataSource{}
+}
+
+type apiKeysDataSource struct {
+	client *sendgrid.Client
+}
+
+type apiKeysDataSourceModel struct {
+	NameRegex  types.String   `tfsdk:"name_regex"`
+	OnBehalfOf types.String   `tfsdk:"on_behalf_of"`
+	APIKeys    []apiKeysModel `tfsdk:"api_keys"`
+}
+
+type apiKeysModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *apiKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_keys"
+}
+
+func (d *apiKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *apiKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the API keys of your SendGrid account.
+
+The API key values and their scopes are not listed; use the ` + "`sendgrid_api_key`" + ` data source to read the scopes of a key.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex":   regexFilterAttribute("API keys", "name"),
+			"on_behalf_of": onBehalfOfDataSourceAttribute(),
+			"api_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "The API keys matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the API key.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the API key.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *apiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data apiKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, err := newRegexFilter(data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading api keys",
+			fmt.Sprintf("Unable to compile name_regex, got error: %s", err),
+		)
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, data.OnBehalfOf.ValueString())
+
+	keys, err := listAllAPIKeys(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading api keys",
+			fmt.Sprintf("Unable to list api keys, got error: %s", err),
+		)
+		return
+	}
+
+	data.APIKeys = []apiKeysModel{}
+	for _, v := range keys {
+		if !nameRegex.match(v.Name) {
+			continue
+		}
+		data.APIKeys = append(data.APIKeys, apiKeysModel{
+			ID:   types.StringValue(v.ApiKeyId),
+			Name: types.StringValue(v.Name),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
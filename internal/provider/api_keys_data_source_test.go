@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPIKeysDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_api_keys.test"
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAPIKeysDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "api_keys.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "api_keys.0.id", "sendgrid_api_key.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "api_keys.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIKeysDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_api_key" "test" {
+	name = "%s"
+	scopes = [
+		"user.profile.read",
+	]
+}
+
+data "sendgrid_api_keys" "test" {
+	name_regex = "^${sendgrid_api_key.test.name}$"
+}
+`, name)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &blocksDataSource{}
+	_ datasource.DataSourceWithConfigure = &blocksDataSource{}
+)
+
+func newBlocksDataSource() datasource.DataSource {
+	return &blocksDataSource{}
+}
+
+type blocksDataSource struct {
+	client *sendgrid.Client
+}
+
+type blocksDataSourceModel struct {
+	StartTime types.Int64   `tfsdk:"start_time"`
+	EndTime   types.Int64   `tfsdk:"end_time"`
+	Email     types.String  `tfsdk:"email"`
+	Blocks    []blocksModel `tfsdk:"blocks"`
+}
+
+type blocksModel struct {
+	Email   types.String `tfsdk:"email"`
+	Created types.Int64  `tfsdk:"created"`
+	Reason  types.String `tfsdk:"reason"`
+}
+
+func (d *blocksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blocks"
+}
+
+func (d *blocksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *blocksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the blocks of your SendGrid account.
+
+Blocks are addresses whose receiving server rejected your emails for a reason unrelated to the recipient, such as your IP address being blocked by an ISP.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"blocks": schema.ListNestedAttribute{
+				MarkdownDescription: "The blocks matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the block.",
+							Computed:            true,
+						},
+						"created": schema.Int64Attribute{
+							MarkdownDescription: "The Unix timestamp of when the block was created.",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "The reason of the block, as reported by the receiving server.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, suppressionListFilterAttributes("blocks"))
+}
+
+func (d *blocksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data blocksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listAllSuppressions(ctx, suppressionListOptions(data.StartTime, data.EndTime, data.Email), d.client.GetBlocks)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading blocks",
+			fmt.Sprintf("Unable to list blocks, got error: %s", err),
+		)
+		return
+	}
+
+	data.Blocks = make([]blocksModel, 0, len(items))
+	for _, v := range items {
+		data.Blocks = append(data.Blocks, blocksModel{
+			Email:   types.StringValue(v.Email),
+			Created: types.Int64Value(v.Created),
+			Reason:  types.StringValue(v.Reason),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
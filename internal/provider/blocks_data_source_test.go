@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/kenzo0107/sendgrid"
+)
+
+func TestAccBlocksDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "sendgrid_blocks" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sendgrid_blocks.test", "blocks.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlocksDataSource_filters(t *testing.T) {
+	server := testAccOffline(t)
+
+	server.AddBlock(sendgrid.Block{Email: "old-blocks@example.com", Created: 1700000000, Reason: "550 5.7.1 Blocked by policy"})
+	server.AddBlock(sendgrid.Block{Email: "new-blocks@example.com", Created: 1800000000, Reason: "550 5.7.1 Blocked by policy"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBlocksDataSourceFiltersConfig(1750000000, 1850000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_blocks.test", "blocks.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_blocks.test", "blocks.0.email", "new-blocks@example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_blocks.test", "blocks.0.created", "1800000000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlocksDataSourceFiltersConfig(startTime, endTime int64) string {
+	return fmt.Sprintf(`
+data "sendgrid_blocks" "test" {
+  start_time = %d
+  end_time   = %d
+}
+`, startTime, endTime)
+}
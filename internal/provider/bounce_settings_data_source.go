@@ -93,9 +93,7 @@ func (d *bounceSettingsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return d.client.GetBounceSettings(ctx)
-	})
+	o, err := d.client.GetBounceSettings(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading bounce settings",
@@ -104,15 +102,6 @@ func (d *bounceSettingsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputGetBounceSettings)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Reading bounce settings",
-			"Failed to assert type *sendgrid.BounceSettings",
-		)
-		return
-	}
-
 	u := bounceSettingsDataSourceModel{
 		Enabled:     types.BoolValue(o.Enabled),
 		SoftBounces: types.Int64Value(o.SoftBounces),
@@ -102,9 +102,7 @@ func (r *bounceSettingsResource) Create(ctx context.Context, req resource.Create
 		HardBounces: plan.HardBounces.ValueInt64(),
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.UpdateBounceSettings(ctx, input)
-	})
+	o, err := r.client.UpdateBounceSettings(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating bounce settings",
@@ -113,15 +111,6 @@ func (r *bounceSettingsResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputUpdateBounceSettings)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating bounce settings",
-			"Failed to assert type *sendgrid.OutputUpdateBounceSettings",
-		)
-		return
-	}
-
 	plan = bounceSettingsResourceModel{
 		Enabled:     types.BoolValue(o.Enabled),
 		SoftBounces: types.Int64Value(o.SoftBounces),
@@ -140,9 +129,7 @@ func (r *bounceSettingsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.GetBounceSettings(ctx)
-	})
+	o, err := r.client.GetBounceSettings(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Reading bounce settings",
@@ -151,15 +138,6 @@ func (r *bounceSettingsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputGetBounceSettings)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Reading bounce settings",
-			"Failed to assert type *sendgrid.OutputGetBounceSettings",
-		)
-		return
-	}
-
 	state = bounceSettingsResourceModel{
 		SoftBounces: types.Int64Value(o.SoftBounces),
 		HardBounces: types.Int64Value(o.HardBounces),
@@ -184,9 +162,7 @@ func (r *bounceSettingsResource) Update(ctx context.Context, req resource.Update
 		SoftBounces: data.SoftBounces.ValueInt64(),
 		HardBounces: data.HardBounces.ValueInt64(),
 	}
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.UpdateBounceSettings(ctx, input)
-	})
+	o, err := r.client.UpdateBounceSettings(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Updating bounce settings",
@@ -195,15 +171,6 @@ func (r *bounceSettingsResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputUpdateBounceSettings)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Updating bounce settings",
-			"Failed to assert type *sendgrid.OutputUpdateBounceSettings",
-		)
-		return
-	}
-
 	data = bounceSettingsResourceModel{
 		Enabled:     types.BoolValue(o.Enabled),
 		SoftBounces: types.Int64Value(o.SoftBounces),
@@ -227,9 +194,7 @@ func (r *bounceSettingsResource) Delete(ctx context.Context, req resource.Delete
 	input := &sendgrid.InputUpdateBounceSettings{
 		Enabled: false,
 	}
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.UpdateBounceSettings(ctx, input)
-	})
+	_, err := r.client.UpdateBounceSettings(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting bounce settings",
@@ -242,9 +207,7 @@ func (r *bounceSettingsResource) Delete(ctx context.Context, req resource.Delete
 func (r *bounceSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data bounceSettingsResourceModel
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.GetBounceSettings(ctx)
-	})
+	o, err := r.client.GetBounceSettings(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing bounce settings",
@@ -253,15 +216,6 @@ func (r *bounceSettingsResource) ImportState(ctx context.Context, req resource.I
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputGetBounceSettings)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Importing bounce settings",
-			"Failed to assert type *sendgrid.OutputGetBounceSettings",
-		)
-		return
-	}
-
 	data = bounceSettingsResourceModel{
 		Enabled:     types.BoolValue(o.Enabled),
 		SoftBounces: types.Int64Value(o.SoftBounces),
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &bouncesDataSource{}
+	_ datasource.DataSourceWithConfigure = &bouncesDataSource{}
+)
+
+func newBouncesDataSource() datasource.DataSource {
+	return &bouncesDataSource{}
+}
+
+type bouncesDataSource struct {
+	client *sendgrid.Client
+}
+
+type bouncesDataSourceModel struct {
+	StartTime types.Int64    `tfsdk:"start_time"`
+	EndTime   types.Int64    `tfsdk:"end_time"`
+	Email     types.String   `tfsdk:"email"`
+	Bounces   []bouncesModel `tfsdk:"bounces"`
+}
+
+type bouncesModel struct {
+	Email   types.String `tfsdk:"email"`
+	Created types.Int64  `tfsdk:"created"`
+	Reason  types.String `tfsdk:"reason"`
+	Status  types.String `tfsdk:"status"`
+}
+
+func (d *bouncesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bounces"
+}
+
+func (d *bouncesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *bouncesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the bounces of your SendGrid account.
+
+Bounces are addresses whose receiving server could not or would not accept your emails. SendGrid suppresses them until they are removed from the list.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"bounces": schema.ListNestedAttribute{
+				MarkdownDescription: "The bounces matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the bounce.",
+							Computed:            true,
+						},
+						"created": schema.Int64Attribute{
+							MarkdownDescription: "The Unix timestamp of when the bounce was created.",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "The reason of the bounce, as reported by the receiving server.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The enhanced SMTP status code of the bounce.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, suppressionListFilterAttributes("bounces"))
+}
+
+func (d *bouncesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data bouncesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listAllSuppressions(ctx, suppressionListOptions(data.StartTime, data.EndTime, data.Email), d.client.GetBounces)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading bounces",
+			fmt.Sprintf("Unable to list bounces, got error: %s", err),
+		)
+		return
+	}
+
+	data.Bounces = make([]bouncesModel, 0, len(items))
+	for _, v := range items {
+		data.Bounces = append(data.Bounces, bouncesModel{
+			Email:   types.StringValue(v.Email),
+			Created: types.Int64Value(v.Created),
+			Reason:  types.StringValue(v.Reason),
+			Status:  types.StringValue(v.Status),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
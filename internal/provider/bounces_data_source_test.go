@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/kenzo0107/sendgrid"
+)
+
+func TestAccBouncesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "sendgrid_bounces" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sendgrid_bounces.test", "bounces.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBouncesDataSource_filters(t *testing.T) {
+	server := testAccOffline(t)
+
+	server.AddBounce(sendgrid.Bounce{Email: "old-bounces@example.com", Created: 1700000000, Reason: "550 5.1.1 The email account that you tried to reach does not exist", Status: "5.1.1"})
+	server.AddBounce(sendgrid.Bounce{Email: "new-bounces@example.com", Created: 1800000000, Reason: "550 5.1.1 The email account that you tried to reach does not exist", Status: "5.1.1"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBouncesDataSourceFiltersConfig(1750000000, 1850000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.test", "bounces.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.test", "bounces.0.email", "new-bounces@example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.test", "bounces.0.created", "1800000000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBouncesDataSourceFiltersConfig(startTime, endTime int64) string {
+	return fmt.Sprintf(`
+data "sendgrid_bounces" "test" {
+  start_time = %d
+  end_time   = %d
+}
+`, startTime, endTime)
+}
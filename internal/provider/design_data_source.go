@@ -7,8 +7,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
 )
@@ -73,12 +76,20 @@ For more detailed information, please see the [SendGrid documentation](https://d
 		`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the design.",
-				Required:            true,
+				MarkdownDescription: "The ID of the design. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the design.",
+				MarkdownDescription: "The name of the design. Exactly one of `id` or `name` must be set; the lookup fails unless exactly one design has this name.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
 			},
 			"editor": schema.StringAttribute{
 				MarkdownDescription: "The editor used in the UI. Allowed values: `code`, `design`.",
@@ -130,6 +141,31 @@ func (d *designDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	id := s.ID.ValueString()
+	if !s.Name.IsNull() {
+		designs, err := listAllDesigns(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading design",
+				fmt.Sprintf("Unable to list designs, got error: %s", err),
+			)
+			return
+		}
+
+		var ids []string
+		for _, v := range designs {
+			if v.Name == s.Name.ValueString() {
+				ids = append(ids, v.ID)
+			}
+		}
+		id, err = singleIDByName("design", s.Name.ValueString(), ids)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading design",
+				fmt.Sprintf("Unable to look up design by name, got error: %s", err),
+			)
+			return
+		}
+	}
 
 	o, err := d.client.GetDesign(ctx, id)
 	if err != nil {
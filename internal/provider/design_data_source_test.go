@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -53,3 +54,78 @@ data "sendgrid_design" "test" {
 }
 `, name, subject)
 }
+
+func TestAccDesignDataSource_name(t *testing.T) {
+	resourceName := "data.sendgrid_design.test"
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDesignDataSourceConfigName(name, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "sendgrid_design.test.0", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Several designs have the name
+			{
+				Config:      testAccDesignDataSourceConfigName(name, 2),
+				ExpectError: regexp.MustCompile(`2\s+designs\s+named\s+"` + name + `"\s+were\s+found`),
+			},
+			// No design has the name
+			{
+				Config:      testAccDesignDataSourceConfigName(name, 0),
+				ExpectError: regexp.MustCompile(`no\s+design\s+named\s+"` + name + `"\s+was\s+found`),
+			},
+		},
+	})
+}
+
+func TestAccDesignDataSource_idAndName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "sendgrid_design" "test" {
+	id   = "00000000-0000-0000-0000-000000000000"
+	name = "test-acc"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+data "sendgrid_design" "test" {}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccDesignDataSourceConfigName(name string, count int) string {
+	return fmt.Sprintf(`
+resource "sendgrid_design" "test" {
+	count = %[2]d
+	name         = "%[1]s"
+	subject      = "test-acc"
+	editor       = "code"
+	html_content = "<html><body><p>Hello</p></body></html>"
+}
+
+data "sendgrid_design" "test" {
+	name = "%[1]s"
+
+	depends_on = [sendgrid_design.test]
+}
+`, name, count)
+}
@@ -7,8 +7,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &designResource{}
 var _ resource.ResourceWithImportState = &designResource{}
+var _ resource.ResourceWithIdentity = &designResource{}
 
 func newDesignResource() resource.Resource {
 	return &designResource{}
@@ -45,6 +46,16 @@ type designResourceModel struct {
 	CreatedAt            types.String `tfsdk:"created_at"`
 }
 
+type designIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (m designResourceModel) identity() designIdentityModel {
+	return designIdentityModel{
+		ID: m.ID,
+	}
+}
+
 func (r *designResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_design"
 }
@@ -119,6 +130,17 @@ For more detailed information, please see the [SendGrid documentation](https://d
 	}
 }
 
+func (r *designResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the design.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *designResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -164,9 +186,7 @@ func (r *designResource) Create(ctx context.Context, req resource.CreateRequest,
 		input.Categories = flex.ExpandFrameworkStringSet(ctx, plan.Categories)
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateDesign(ctx, input)
-	})
+	o, err := r.client.CreateDesign(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating design",
@@ -175,15 +195,6 @@ func (r *designResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateDesign)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating design",
-			"Failed to assert type *sendgrid.OutputCreateDesign",
-		)
-		return
-	}
-
 	categories, d := types.SetValueFrom(ctx, types.StringType, o.Categories)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -204,6 +215,7 @@ func (r *designResource) Create(ctx context.Context, req resource.CreateRequest,
 		CreatedAt:            types.StringValue(o.CreatedAt),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *designResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -248,6 +260,7 @@ func (r *designResource) Read(ctx context.Context, req resource.ReadRequest, res
 		CreatedAt:            types.StringValue(o.CreatedAt),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *designResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -307,6 +320,7 @@ func (r *designResource) Update(ctx context.Context, req resource.UpdateRequest,
 		CreatedAt:            types.StringValue(o.CreatedAt),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *designResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -318,9 +332,7 @@ func (r *designResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	id := state.ID.ValueString()
 
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteDesign(ctx, id)
-	})
+	err := r.client.DeleteDesign(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting design",
@@ -333,9 +345,10 @@ func (r *designResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *designResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data designResourceModel
 
-	id := req.ID
-
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importIDOrIdentity(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	o, err := r.client.GetDesign(ctx, id)
 	if err != nil {
@@ -366,4 +379,5 @@ func (r *designResource) ImportState(ctx context.Context, req resource.ImportSta
 		CreatedAt:            types.StringValue(o.CreatedAt),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
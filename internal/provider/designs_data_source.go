@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &designsDataSource{}
+	_ datasource.DataSourceWithConfigure = &designsDataSource{}
+)
+
+func newDesignsDataSource() datasource.DataSource {
+	return &designsDataSource{}
+}
+
+type designsDataSource struct {
+	client *sendgrid.Client
+}
+
+type designsDataSourceModel struct {
+	NameRegex  types.String   `tfsdk:"name_regex"`
+	OnBehalfOf types.String   `tfsdk:"on_behalf_of"`
+	Designs    []designsModel `tfsdk:"designs"`
+}
+
+type designsModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Editor    types.String `tfsdk:"editor"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *designsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_designs"
+}
+
+func (d *designsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *designsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the designs of your SendGrid account.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex":   regexFilterAttribute("designs", "name"),
+			"on_behalf_of": onBehalfOfDataSourceAttribute(),
+			"designs": schema.ListNestedAttribute{
+				MarkdownDescription: "The designs matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the design.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the design.",
+							Computed:            true,
+						},
+						"editor": schema.StringAttribute{
+							MarkdownDescription: "The editor used to build the design, `code` or `design`.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The date and time the design was created.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "The date and time the design was last updated.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *designsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data designsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, err := newRegexFilter(data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading designs",
+			fmt.Sprintf("Unable to compile name_regex, got error: %s", err),
+		)
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, data.OnBehalfOf.ValueString())
+
+	items, err := listAllDesigns(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading designs",
+			fmt.Sprintf("Unable to list designs, got error: %s", err),
+		)
+		return
+	}
+
+	data.Designs = []designsModel{}
+	for _, v := range items {
+		if !nameRegex.match(v.Name) {
+			continue
+		}
+		data.Designs = append(data.Designs, designsModel{
+			ID:        types.StringValue(v.ID),
+			Name:      types.StringValue(v.Name),
+			Editor:    types.StringValue(v.Editor),
+			CreatedAt: types.StringValue(v.CreatedAt),
+			UpdatedAt: types.StringValue(v.UpdatedAt),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
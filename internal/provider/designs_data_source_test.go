@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDesignsDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_designs.test"
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDesignsDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "designs.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "designs.0.id", "sendgrid_design.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "designs.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "designs.0.editor", "code"),
+					resource.TestCheckResourceAttrSet(resourceName, "designs.0.created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "designs.0.updated_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDesignsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_design" "test" {
+  name         = "%s"
+  subject      = "test-acc"
+  editor       = "code"
+  html_content = "<html><body><p>Hello</p></body></html>"
+}
+
+data "sendgrid_designs" "test" {
+  name_regex = "^${sendgrid_design.test.name}$"
+}
+`, name)
+}
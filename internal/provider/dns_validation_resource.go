@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &dnsValidationResource{}
+
+// dnsValidationDescriptor describes a resource whose DNS records SendGrid
+// validates, for the resource that waits for them to be valid.
+type dnsValidationDescriptor struct {
+	typeName    string // e.g. "_sender_authentication_validation"
+	target      string // the resource validated, e.g. "sendgrid_sender_authentication"
+	idAttribute string // the attribute holding its ID, e.g. "domain_id"
+	what        string // e.g. "the domain"
+	title       string // used in diagnostics, e.g. "sender authentication"
+
+	// validate asks SendGrid to validate the DNS records once.
+	validate func(ctx context.Context, client *sendgrid.Client, id int64) (bool, []validationFailure, error)
+	// valid reads whether the DNS records were last found valid.
+	valid func(ctx context.Context, client *sendgrid.Client, id int64) (bool, error)
+}
+
+var senderAuthenticationValidation = dnsValidationDescriptor{
+	typeName:    "_sender_authentication_validation",
+	target:      "sendgrid_sender_authentication",
+	idAttribute: "domain_id",
+	what:        "the domain",
+	title:       "sender authentication",
+	validate:    validateAuthenticatedDomain,
+	valid: func(ctx context.Context, client *sendgrid.Client, id int64) (bool, error) {
+		o, err := client.GetAuthenticatedDomain(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return o.Valid, nil
+	},
+}
+
+var linkBrandingValidation = dnsValidationDescriptor{
+	typeName:    "_link_branding_validation",
+	target:      "sendgrid_link_branding",
+	idAttribute: "link_id",
+	what:        "the branded link",
+	title:       "branded link",
+	validate:    validateBrandedLink,
+	valid: func(ctx context.Context, client *sendgrid.Client, id int64) (bool, error) {
+		o, err := client.GetBrandedLink(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return o.Valid, nil
+	},
+}
+
+var reverseDNSValidation = dnsValidationDescriptor{
+	typeName:    "_reverse_dns_validation",
+	target:      "sendgrid_reverse_dns",
+	idAttribute: "reverse_dns_id",
+	what:        "the reverse DNS",
+	title:       "reverse DNS",
+	validate:    validateReverseDNS,
+	valid: func(ctx context.Context, client *sendgrid.Client, id int64) (bool, error) {
+		o, err := client.GetReverseDNS(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return o.Valid, nil
+	},
+}
+
+func newSenderAuthenticationValidationResource() resource.Resource {
+	return &dnsValidationResource{descriptor: senderAuthenticationValidation}
+}
+
+func newLinkBrandingValidationResource() resource.Resource {
+	return &dnsValidationResource{descriptor: linkBrandingValidation}
+}
+
+func newReverseDNSValidationResource() resource.Resource {
+	return &dnsValidationResource{descriptor: reverseDNSValidation}
+}
+
+// dnsValidationResource waits for the DNS records of another resource to be
+// valid. Unlike wait_for_validation, it can depend on the DNS records built
+// from the attributes of that resource, so that both are created in one
+// apply.
+type dnsValidationResource struct {
+	client     *sendgrid.Client
+	descriptor dnsValidationDescriptor
+}
+
+func (r *dnsValidationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.descriptor.typeName
+}
+
+func (r *dnsValidationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	d := r.descriptor
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(`
+Waits for the DNS records of %[1]s of a `+"`%[2]s`"+` resource to be valid, asking SendGrid to validate them until they are. Once `+"`validation_timeout`"+` expires, the apply fails with the records that are still failing, and the next apply waits again.
+
+This resource does not manage anything in SendGrid. Make it depend on the DNS records published from the `+"`dns`"+` attribute of %[1]s, so that %[1]s, its DNS records and their validation are created in one apply. When %[1]s is found invalid on refresh, this resource is created again, to validate it again.
+		`, d.what, d.target),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The ID of %s.", d.what),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			d.idAttribute: schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The ID of %s to validate.", d.what),
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"validation_timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long to wait for the DNS records of %s to be valid. (default: `%s`)", d.what, defaultValidationTimeout),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultValidationTimeout),
+				Validators: []validator.String{
+					stringDuration(),
+				},
+			},
+			"validation_poll_interval": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long to wait between validation attempts. (default: `%s`)", defaultValidationPollInterval),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultValidationPollInterval),
+				Validators: []validator.String{
+					stringDuration(),
+				},
+			},
+		},
+	}
+}
+
+func (r *dnsValidationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dnsValidationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	d := r.descriptor
+
+	var id types.Int64
+	var timeoutValue, intervalValue types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(d.idAttribute), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("validation_timeout"), &timeoutValue)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("validation_poll_interval"), &intervalValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, interval := validationSettings(timeoutValue, intervalValue)
+	failures, err := waitForValidation(ctx, interval, timeout, func(ctx context.Context) (bool, []validationFailure, error) {
+		return d.validate(ctx, r.client, id.ValueInt64())
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Validating "+d.title,
+			fmt.Sprintf("Unable to validate %s (id: %d), got error: %s", d.what, id.ValueInt64(), err),
+		)
+		return
+	}
+	// Nothing is saved, so the next apply waits again.
+	if len(failures) > 0 {
+		resp.Diagnostics.AddError(
+			"Validating "+d.title,
+			fmt.Sprintf("The DNS records of %s (id: %d) were not valid after %s:\n%s", d.what, id.ValueInt64(), timeout, formatValidationFailures(failures)),
+		)
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(id.ValueInt64(), 10))...)
+}
+
+func (r *dnsValidationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	d := r.descriptor
+
+	var id types.Int64
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(d.idAttribute), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	valid, err := d.valid(ctx, r.client, id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading "+d.title,
+			fmt.Sprintf("Unable to read %s (id: %d), got error: %s", d.what, id.ValueInt64(), err),
+		)
+		return
+	}
+	if !valid {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r *dnsValidationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only the timeout and poll interval can change without replacing this
+	// resource, and they only matter while waiting.
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *dnsValidationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Validation cannot be undone, so it is only removed from the state.
+}
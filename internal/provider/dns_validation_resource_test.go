@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSenderAuthenticationValidationResource(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_sender_authentication_validation.test"
+	domainResourceName := "sendgrid_sender_authentication.test"
+
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Waiting times out while the DNS records are missing, which leaves
+			// the domain as it is
+			{
+				Config:      testAccSenderAuthenticationValidationResourceConfig(domain),
+				ExpectError: regexp.MustCompile(`(?s)valid after 1s:.*mail_cname.*dkim1.*dkim2`),
+			},
+			// Validation is waited for again once the DNS records are published
+			{
+				PreConfig: func() {
+					server.PublishDNSRecord("mail." + domain)
+					server.PublishDNSRecord("s1._domainkey." + domain)
+					server.PublishDNSRecord("s2._domainkey." + domain)
+				},
+				Config: testAccSenderAuthenticationValidationResourceConfig(domain),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(domainResourceName, plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", domainResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", domainResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "validation_timeout", "1s"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSenderAuthenticationValidationResourceConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_sender_authentication" "test" {
+  domain    = "%s"
+  subdomain = "mail"
+}
+
+resource "sendgrid_sender_authentication_validation" "test" {
+  domain_id                = sendgrid_sender_authentication.test.id
+  validation_timeout       = "1s"
+  validation_poll_interval = "100ms"
+}
+`, domain)
+}
+
+func TestAccLinkBrandingValidationResource(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_link_branding_validation.test"
+	linkResourceName := "sendgrid_link_branding.test"
+
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+	var hosts []string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the branded link, to learn the hosts of its DNS records
+			{
+				Config: testAccLinkBrandingResourceConfigWaitForValidation(domain, false),
+				Check:  testAccCheckLinkBrandingDNSHosts(linkResourceName, &hosts),
+			},
+			// Waiting times out while the DNS records are missing, which leaves
+			// the branded link as it is
+			{
+				Config:      testAccLinkBrandingValidationResourceConfig(domain),
+				ExpectError: regexp.MustCompile(`(?s)valid after 1s:.*domain_cname.*owner_cname`),
+			},
+			// Validation is waited for again once the DNS records are published
+			{
+				PreConfig: func() {
+					for _, host := range hosts {
+						server.PublishDNSRecord(host)
+					}
+				},
+				Config: testAccLinkBrandingValidationResourceConfig(domain),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(linkResourceName, plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", linkResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "link_id", linkResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLinkBrandingValidationResourceConfig(domain string) string {
+	return testAccLinkBrandingResourceConfigWaitForValidation(domain, false) + `
+resource "sendgrid_link_branding_validation" "test" {
+  link_id                  = sendgrid_link_branding.test.id
+  validation_timeout       = "1s"
+  validation_poll_interval = "100ms"
+}
+`
+}
+
+func TestAccReverseDNSValidationResource(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_reverse_dns_validation.test"
+	reverseDNSResourceName := "sendgrid_reverse_dns.test"
+
+	ip := os.Getenv("IP_ADDRESS")
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Waiting times out while the A record is missing, which leaves the
+			// reverse DNS as it is
+			{
+				Config:      testAccReverseDNSValidationResourceConfig(ip, domain),
+				ExpectError: regexp.MustCompile(`(?s)valid after 1s:.*a_record: Expected A`),
+			},
+			// Validation is waited for again once the A record is published
+			{
+				PreConfig: func() {
+					server.PublishDNSRecord("o1." + domain)
+				},
+				Config: testAccReverseDNSValidationResourceConfig(ip, domain),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(reverseDNSResourceName, plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", reverseDNSResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "reverse_dns_id", reverseDNSResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReverseDNSValidationResourceConfig(ip, domain string) string {
+	return testAccReverseDNSResourceConfig(ip, domain, false) + `
+resource "sendgrid_reverse_dns_validation" "test" {
+  reverse_dns_id           = sendgrid_reverse_dns.test.id
+  validation_timeout       = "1s"
+  validation_poll_interval = "100ms"
+}
+`
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResourceWithConfigure = &eventWebhookListResource{}
+
+func newEventWebhookListResource() list.ListResource {
+	return &eventWebhookListResource{}
+}
+
+type eventWebhookListResource struct {
+	eventWebhookResource
+}
+
+type eventWebhookListResourceModel struct {
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (r *eventWebhookListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the event webhooks of your SendGrid account. SendGrid does not return the OAuth client secret of a webhook, so it is not set.",
+		Attributes: map[string]listschema.Attribute{
+			"on_behalf_of": listOnBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *eventWebhookListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data eventWebhookListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, data.OnBehalfOf.ValueString())
+
+	o, err := r.client.GetEventWebhooks(ctx)
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing event webhooks",
+			fmt.Sprintf("Unable to list event webhooks, got error: %s", err),
+		)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		push = limitResults(req, push)
+		for _, v := range o.Webhooks {
+			m := eventWebhookModelFrom((*sendgrid.OutputGetEventWebhook)(v), data.OnBehalfOf)
+			displayName := v.FriendlyName
+			if displayName == "" {
+				displayName = v.URL
+			}
+			if !push(newListResult(ctx, req, displayName, m.identity(), &m)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEventWebhookListResource(t *testing.T) {
+	url := fmt.Sprintf("https://test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventWebhookResourceConfig(url, true),
+			},
+			// ImportState by identity testing
+			{
+				ResourceName:    "sendgrid_event_webhook.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				Query:  true,
+				Config: testAccEventWebhookListResourceQuery(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("sendgrid_event_webhook.test", 1),
+					querycheck.ExpectResourceKnownValues("sendgrid_event_webhook.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(url)),
+						[]querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("url"), KnownValue: knownvalue.StringExact(url)},
+							{Path: tfjsonpath.New("enabled"), KnownValue: knownvalue.Bool(true)},
+							{Path: tfjsonpath.New("on_behalf_of"), KnownValue: knownvalue.Null()},
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccEventWebhookListResourceQuery() string {
+	return `
+list "sendgrid_event_webhook" "test" {
+	provider         = sendgrid
+	include_resource = true
+}
+`
+}
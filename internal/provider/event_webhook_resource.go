@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &eventWebhookResource{}
 var _ resource.ResourceWithImportState = &eventWebhookResource{}
+var _ resource.ResourceWithIdentity = &eventWebhookResource{}
 
 func newEventWebhookResource() resource.Resource {
 	return &eventWebhookResource{}
@@ -47,6 +49,19 @@ type eventWebhookResourceModel struct {
 	OAuthTokenURL     types.String `tfsdk:"oauth_token_url"`
 	Signed            types.Bool   `tfsdk:"signed"`
 	PublicKey         types.String `tfsdk:"public_key"`
+	OnBehalfOf        types.String `tfsdk:"on_behalf_of"`
+}
+
+type eventWebhookIdentityModel struct {
+	ID         types.String `tfsdk:"id"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (m eventWebhookResourceModel) identity() eventWebhookIdentityModel {
+	return eventWebhookIdentityModel{
+		ID:         m.ID,
+		OnBehalfOf: m.OnBehalfOf,
+	}
 }
 
 func (r *eventWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,6 +73,8 @@ func (r *eventWebhookResource) Schema(ctx context.Context, req resource.SchemaRe
 		MarkdownDescription: `
 The SendGrid Event Webhook sends email event data as SendGrid processes it. This means you can receive data in nearly real-time, making it ideal to integrate with logging or monitoring systems.
 Because the Event Webhook delivers data to your systems, it is also well-suited to backing up and storing event data within your infrastructure to meet your own data access and retention needs.
+
+The ` + "`click`" + `, ` + "`open`" + `, ` + "`unsubscribe`" + `, ` + "`group_unsubscribe`" + ` and ` + "`group_resubscribe`" + ` events are only sent when the matching tracking setting is enabled. The provider warns on apply when it is not; manage the setting with ` + "`sendgrid_click_tracking_settings`" + `, ` + "`sendgrid_open_tracking_settings`" + ` or ` + "`sendgrid_subscription_tracking_settings`" + ` and list it in the ` + "`depends_on`" + ` of the webhook.
 		`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -75,7 +92,7 @@ Because the Event Webhook delivers data to your systems, it is also well-suited
 				Required:            true,
 			},
 			"group_resubscribe": schema.BoolAttribute{
-				MarkdownDescription: "Set this property to true to receive group resubscribe events. Group resubscribes occur when recipients resubscribe to a specific unsubscribe group by updating their subscription preferences. You must enable Subscription Tracking to receive this type of event. (Default: `false`)",
+				MarkdownDescription: "Set this property to true to receive group resubscribe events. Group resubscribes occur when recipients resubscribe to a specific unsubscribe group by updating their subscription preferences. You must enable Subscription Tracking (`sendgrid_subscription_tracking_settings`) to receive this type of event. (Default: `false`)",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
@@ -87,7 +104,7 @@ Because the Event Webhook delivers data to your systems, it is also well-suited
 				Default:             booldefault.StaticBool(false),
 			},
 			"group_unsubscribe": schema.BoolAttribute{
-				MarkdownDescription: "Set this property to true to receive group unsubscribe events. Group unsubscribes occur when recipients unsubscribe from a specific unsubscribe group either by direct link or by updating their subscription preferences. You must enable Subscription Tracking to receive this type of event. (Default: `false`)",
+				MarkdownDescription: "Set this property to true to receive group unsubscribe events. Group unsubscribes occur when recipients unsubscribe from a specific unsubscribe group either by direct link or by updating their subscription preferences. You must enable Subscription Tracking (`sendgrid_subscription_tracking_settings`) to receive this type of event. (Default: `false`)",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
@@ -111,7 +128,7 @@ Because the Event Webhook delivers data to your systems, it is also well-suited
 				Default:             booldefault.StaticBool(false),
 			},
 			"unsubscribe": schema.BoolAttribute{
-				MarkdownDescription: "Set this property to true to receive unsubscribe events. Unsubscribes occur when recipients click on a message's subscription management link. You must enable Subscription Tracking to receive this type of event. (Default: `false`)",
+				MarkdownDescription: "Set this property to true to receive unsubscribe events. Unsubscribes occur when recipients click on a message's subscription management link. You must enable Subscription Tracking (`sendgrid_subscription_tracking_settings`) to receive this type of event. (Default: `false`)",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
@@ -123,13 +140,13 @@ Because the Event Webhook delivers data to your systems, it is also well-suited
 				Default:             booldefault.StaticBool(false),
 			},
 			"open": schema.BoolAttribute{
-				MarkdownDescription: "Set this property to true to receive open events. Open events occur when a recipient has opened the HTML message. You must enable Open Tracking to receive this type of event. (Default: `false`)",
+				MarkdownDescription: "Set this property to true to receive open events. Open events occur when a recipient has opened the HTML message. You must enable Open Tracking (`sendgrid_open_tracking_settings`) to receive this type of event. (Default: `false`)",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
 			"click": schema.BoolAttribute{
-				MarkdownDescription: "Set this property to true to receive click events. Click events occur when a recipient clicks on a link within the message. You must enable Click Tracking to receive this type of event. (Default: `false`)",
+				MarkdownDescription: "Set this property to true to receive click events. Click events occur when a recipient clicks on a link within the message. You must enable Click Tracking (`sendgrid_click_tracking_settings`) to receive this type of event. (Default: `false`)",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
@@ -171,6 +188,19 @@ Because the Event Webhook delivers data to your systems, it is also well-suited
 				MarkdownDescription: "The public key used to verify webhook signatures. This is automatically generated when signature verification is enabled and is read-only.",
 				Computed:            true,
 			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *eventWebhookResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the event webhook.",
+				RequiredForImport: true,
+			},
+			"on_behalf_of": onBehalfOfIdentityAttribute(),
 		},
 	}
 }
@@ -227,9 +257,9 @@ func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRe
 		input.OAuthTokenURL = plan.OAuthTokenURL.ValueString()
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateEventWebhook(context.TODO(), input)
-	})
+	ctx = withOnBehalfOf(ctx, plan.OnBehalfOf.ValueString())
+
+	o, err := r.client.CreateEventWebhook(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating event webhook",
@@ -238,24 +268,13 @@ func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateEventWebhook)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating event webhook",
-			"Failed to assert type *sendgrid.OutputCreateEventWebhook",
-		)
-		return
-	}
-
 	var publicKey string
 	signed := plan.Signed.ValueBool()
 
 	// Handle signature verification if enabled
 	if signed {
-		res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-			return r.client.ToggleSignatureVerification(ctx, o.ID, &sendgrid.InputToggleSignatureVerification{
-				Enabled: true,
-			})
+		toggled, err := r.client.ToggleSignatureVerification(ctx, o.ID, &sendgrid.InputToggleSignatureVerification{
+			Enabled: true,
 		})
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -265,16 +284,7 @@ func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRe
 			return
 		}
 
-		o, ok := res.(*sendgrid.OutputToggleSignatureVerification)
-		if !ok {
-			resp.Diagnostics.AddError(
-				"Enabling signature verification",
-				"Failed to assert type *sendgrid.OutputToggleSignatureVerification",
-			)
-			return
-		}
-
-		publicKey = o.PublicKey
+		publicKey = toggled.PublicKey
 	}
 
 	plan = eventWebhookResourceModel{
@@ -297,11 +307,15 @@ func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRe
 		OAuthTokenURL:    types.StringValue(o.OAuthTokenURL),
 		Signed:           types.BoolValue(signed),
 		PublicKey:        types.StringValue(publicKey),
+		OnBehalfOf:       plan.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(r.checkTrackingSettings(ctx, plan)...)
 }
 
 func (r *eventWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -311,6 +325,8 @@ func (r *eventWebhookResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	id := state.ID.ValueString()
 	o, err := r.client.GetEventWebhook(ctx, id)
 	if err != nil {
@@ -321,28 +337,9 @@ func (r *eventWebhookResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	state = eventWebhookResourceModel{
-		ID:               types.StringValue(o.ID),
-		Enabled:          types.BoolValue(o.Enabled),
-		URL:              types.StringValue(o.URL),
-		GroupResubscribe: types.BoolValue(o.GroupResubscribe),
-		Delivered:        types.BoolValue(o.Delivered),
-		GroupUnsubscribe: types.BoolValue(o.GroupUnsubscribe),
-		SpamReport:       types.BoolValue(o.SpamReport),
-		Bounce:           types.BoolValue(o.Bounce),
-		Deferred:         types.BoolValue(o.Deferred),
-		Unsubscribe:      types.BoolValue(o.Unsubscribe),
-		Processed:        types.BoolValue(o.Processed),
-		Open:             types.BoolValue(o.Open),
-		Click:            types.BoolValue(o.Click),
-		Dropped:          types.BoolValue(o.Dropped),
-		FriendlyName:     types.StringValue(o.FriendlyName),
-		OAuthClientID:    types.StringValue(o.OAuthClientID),
-		OAuthTokenURL:    types.StringValue(o.OAuthTokenURL),
-		Signed:           types.BoolValue(o.PublicKey != ""),
-		PublicKey:        types.StringValue(o.PublicKey),
-	}
+	state = eventWebhookModelFrom(o, state.OnBehalfOf)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -382,6 +379,8 @@ func (r *eventWebhookResource) Update(ctx context.Context, req resource.UpdateRe
 		input.OAuthTokenURL = plan.OAuthTokenURL.ValueString()
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	id := state.ID.ValueString()
 	o, err := r.client.UpdateEventWebhook(ctx, id, input)
 	if err != nil {
@@ -397,10 +396,8 @@ func (r *eventWebhookResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// Handle signature verification separately if it has changed
 	if !plan.Signed.Equal(state.Signed) {
-		res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-			return r.client.ToggleSignatureVerification(ctx, id, &sendgrid.InputToggleSignatureVerification{
-				Enabled: signed,
-			})
+		o, err := r.client.ToggleSignatureVerification(ctx, id, &sendgrid.InputToggleSignatureVerification{
+			Enabled: signed,
 		})
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -410,15 +407,6 @@ func (r *eventWebhookResource) Update(ctx context.Context, req resource.UpdateRe
 			return
 		}
 
-		o, ok := res.(*sendgrid.OutputToggleSignatureVerification)
-		if !ok {
-			resp.Diagnostics.AddError(
-				"Enabling signature verification",
-				"Failed to assert type *sendgrid.OutputToggleSignatureVerification",
-			)
-			return
-		}
-
 		publicKey = o.PublicKey
 	}
 
@@ -442,11 +430,15 @@ func (r *eventWebhookResource) Update(ctx context.Context, req resource.UpdateRe
 		OAuthTokenURL:    types.StringValue(o.OAuthTokenURL),
 		Signed:           types.BoolValue(signed),
 		PublicKey:        types.StringValue(publicKey),
+		OnBehalfOf:       state.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(r.checkTrackingSettings(ctx, data)...)
 }
 
 func (r *eventWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -456,10 +448,10 @@ func (r *eventWebhookResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, data.OnBehalfOf.ValueString())
+
 	id := data.ID.ValueString()
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteEventWebhook(ctx, id)
-	})
+	err := r.client.DeleteEventWebhook(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting event webhook",
@@ -470,7 +462,12 @@ func (r *eventWebhookResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *eventWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
+	onBehalfOf, id := importOnBehalfOfID(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withOnBehalfOf(ctx, onBehalfOf)
+
 	o, err := r.client.GetEventWebhook(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -480,7 +477,22 @@ func (r *eventWebhookResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
-	d := eventWebhookResourceModel{
+	d := eventWebhookModelFrom(o, types.StringNull())
+	if onBehalfOf != "" {
+		d.OnBehalfOf = types.StringValue(onBehalfOf)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, d.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// eventWebhookModelFrom converts an event webhook read from SendGrid to the
+// resource model. oauth_client_secret is null because SendGrid never returns
+// it.
+func eventWebhookModelFrom(o *sendgrid.OutputGetEventWebhook, onBehalfOf types.String) eventWebhookResourceModel {
+	return eventWebhookResourceModel{
 		ID:               types.StringValue(o.ID),
 		Enabled:          types.BoolValue(o.Enabled),
 		URL:              types.StringValue(o.URL),
@@ -500,9 +512,6 @@ func (r *eventWebhookResource) ImportState(ctx context.Context, req resource.Imp
 		OAuthTokenURL:    types.StringValue(o.OAuthTokenURL),
 		Signed:           types.BoolValue(o.PublicKey != ""),
 		PublicKey:        types.StringValue(o.PublicKey),
-	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
-	if resp.Diagnostics.HasError() {
-		return
+		OnBehalfOf:       onBehalfOf,
 	}
 }
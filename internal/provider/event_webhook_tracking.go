@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
+)
+
+// checkTrackingSettings warns about the events of an enabled webhook that
+// SendGrid will not send, because the tracking setting they depend on is
+// disabled. It runs on apply rather than on plan, so that tracking settings
+// enabled earlier in the same apply through depends_on are taken into account.
+func (r *eventWebhookResource) checkTrackingSettings(ctx context.Context, data eventWebhookResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !data.Enabled.ValueBool() {
+		return diags
+	}
+
+	if data.Click.ValueBool() {
+		o, err := r.client.GetClickTrackingSettings(ctx)
+		diags.Append(trackingDependencyDiagnostics("click tracking", "sendgrid_click_tracking_settings", err == nil && o.Enabled, err, "click")...)
+	}
+
+	if data.Open.ValueBool() {
+		o, err := r.client.GetOpenTrackingSettings(ctx)
+		diags.Append(trackingDependencyDiagnostics("open tracking", "sendgrid_open_tracking_settings", err == nil && o.Enabled, err, "open")...)
+	}
+
+	var events []string
+	if data.Unsubscribe.ValueBool() {
+		events = append(events, "unsubscribe")
+	}
+	if data.GroupUnsubscribe.ValueBool() {
+		events = append(events, "group_unsubscribe")
+	}
+	if data.GroupResubscribe.ValueBool() {
+		events = append(events, "group_resubscribe")
+	}
+	if len(events) > 0 {
+		o, err := r.client.GetSubscriptionTrackingSettings(ctx)
+		diags.Append(trackingDependencyDiagnostics("subscription tracking", "sendgrid_subscription_tracking_settings", err == nil && o.Enabled, err, events...)...)
+	}
+
+	return diags
+}
+
+func trackingDependencyDiagnostics(setting, resourceType string, enabled bool, err error, events ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err != nil {
+		diags.AddWarning(
+			fmt.Sprintf("Checking %s settings", setting),
+			fmt.Sprintf("Unable to check that %s is enabled for the %s events of the event webhook, got error: %s", setting, flex.QuoteAndJoin(events), err),
+		)
+		return diags
+	}
+	if !enabled {
+		diags.AddWarning(
+			fmt.Sprintf("Event webhook depends on %s", setting),
+			fmt.Sprintf("The event webhook will not receive the %s events until %s is enabled, for example with the %s resource.", flex.QuoteAndJoin(events), setting, resourceType),
+		)
+	}
+	return diags
+}
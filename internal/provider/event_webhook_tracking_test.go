@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/terraform-provider-sendgrid/internal/sendgridtest"
+)
+
+func TestEventWebhookCheckTrackingSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		webhook      eventWebhookResourceModel
+		openTracking bool
+		wantWarnings []string
+	}{
+		"disabled webhook": {
+			webhook: eventWebhookResourceModel{
+				Enabled: types.BoolValue(false),
+				Open:    types.BoolValue(true),
+			},
+		},
+		"no dependent events": {
+			webhook: eventWebhookResourceModel{
+				Enabled:   types.BoolValue(true),
+				Delivered: types.BoolValue(true),
+			},
+		},
+		"tracking disabled": {
+			webhook: eventWebhookResourceModel{
+				Enabled:          types.BoolValue(true),
+				Click:            types.BoolValue(true),
+				Open:             types.BoolValue(true),
+				GroupUnsubscribe: types.BoolValue(true),
+			},
+			wantWarnings: []string{
+				"Event webhook depends on click tracking",
+				"Event webhook depends on open tracking",
+				"Event webhook depends on subscription tracking",
+			},
+		},
+		"open tracking enabled": {
+			webhook: eventWebhookResourceModel{
+				Enabled: types.BoolValue(true),
+				Open:    types.BoolValue(true),
+			},
+			openTracking: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := sendgridtest.NewServer()
+			defer server.Close()
+
+			client := sendgrid.New("SG.test", sendgrid.OptionBaseURL(server.BaseURL()))
+			if _, err := client.UpdateOpenTrackingSettings(t.Context(), &sendgrid.InputUpdateOpenTrackingSettings{
+				Enabled: test.openTracking,
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			r := &eventWebhookResource{client: client}
+			diags := r.checkTrackingSettings(t.Context(), test.webhook)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags.Errors())
+			}
+			var got []string
+			for _, d := range diags.Warnings() {
+				got = append(got, d.Summary())
+			}
+			if len(got) != len(test.wantWarnings) {
+				t.Fatalf("warnings = %q, want %q", got, test.wantWarnings)
+			}
+			for i := range got {
+				if got[i] != test.wantWarnings[i] {
+					t.Errorf("warnings[%d] = %q, want %q", i, got[i], test.wantWarnings[i])
+				}
+			}
+		})
+	}
+}
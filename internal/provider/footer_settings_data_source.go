@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &footerSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &footerSettingsDataSource{}
+)
+
+func newFooterSettingsDataSource() datasource.DataSource {
+	return &footerSettingsDataSource{}
+}
+
+type footerSettingsDataSource struct {
+	client *sendgrid.Client
+}
+
+type footerSettingsDataSourceModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	HTMLContent  types.String `tfsdk:"html_content"`
+	PlainContent types.String `tfsdk:"plain_content"`
+}
+
+func (d *footerSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_footer_settings"
+}
+
+func (d *footerSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *footerSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Retrieve the footer mail setting of your SendGrid account.
+
+The Footer setting inserts a custom footer at the bottom of the text and HTML bodies of your emails.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the footer mail setting is enabled.",
+				Computed:            true,
+			},
+			"html_content": schema.StringAttribute{
+				MarkdownDescription: "The custom HTML content of the footer.",
+				Computed:            true,
+			},
+			"plain_content": schema.StringAttribute{
+				MarkdownDescription: "The custom plain text content of the footer.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *footerSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state footerSettingsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getMailSetting[mailSettingFooter](ctx, d.client, "footer")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading footer settings",
+			fmt.Sprintf("Unable to get footer settings, got error: %s", err),
+		)
+		return
+	}
+
+	u := footerSettingsDataSourceModel{
+		Enabled:      types.BoolValue(o.Enabled),
+		HTMLContent:  types.StringValue(o.HTMLContent),
+		PlainContent: types.StringValue(o.PlainContent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &u)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &footerSettingsResource{}
+var _ resource.ResourceWithImportState = &footerSettingsResource{}
+
+func newFooterSettingsResource() resource.Resource {
+	return &footerSettingsResource{}
+}
+
+type footerSettingsResource struct {
+	client *sendgrid.Client
+}
+
+type footerSettingsResourceModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	HTMLContent  types.String `tfsdk:"html_content"`
+	PlainContent types.String `tfsdk:"plain_content"`
+}
+
+func (r *footerSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_footer_settings"
+}
+
+func (r *footerSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the footer mail setting of your SendGrid account.
+
+The Footer setting inserts a custom footer at the bottom of the text and HTML bodies of your emails.
+
+Destroying this resource disables the setting and clears its content.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the footer mail setting is enabled.",
+				Required:            true,
+			},
+			"html_content": schema.StringAttribute{
+				MarkdownDescription: "The custom HTML content of the footer.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"plain_content": schema.StringAttribute{
+				MarkdownDescription: "The custom plain text content of the footer.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (r *footerSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *footerSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan footerSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := updateMailSetting(ctx, r.client, "footer", &mailSettingFooter{
+		Enabled:      plan.Enabled.ValueBool(),
+		HTMLContent:  plan.HTMLContent.ValueString(),
+		PlainContent: plan.PlainContent.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating footer settings",
+			fmt.Sprintf("Unable to update footer settings, got error: %s", err),
+		)
+		return
+	}
+
+	plan = footerSettingsResourceModel{
+		Enabled:      types.BoolValue(o.Enabled),
+		HTMLContent:  types.StringValue(o.HTMLContent),
+		PlainContent: types.StringValue(o.PlainContent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *footerSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state footerSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getMailSetting[mailSettingFooter](ctx, r.client, "footer")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading footer settings",
+			fmt.Sprintf("Unable to read footer settings, got error: %s", err),
+		)
+		return
+	}
+
+	state = footerSettingsResourceModel{
+		Enabled:      types.BoolValue(o.Enabled),
+		HTMLContent:  types.StringValue(o.HTMLContent),
+		PlainContent: types.StringValue(o.PlainContent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *footerSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data footerSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := updateMailSetting(ctx, r.client, "footer", &mailSettingFooter{
+		Enabled:      data.Enabled.ValueBool(),
+		HTMLContent:  data.HTMLContent.ValueString(),
+		PlainContent: data.PlainContent.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating footer settings",
+			fmt.Sprintf("Unable to update footer settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = footerSettingsResourceModel{
+		Enabled:      types.BoolValue(o.Enabled),
+		HTMLContent:  types.StringValue(o.HTMLContent),
+		PlainContent: types.StringValue(o.PlainContent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *footerSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state footerSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mail settings cannot be deleted, so reset the setting to its default.
+	_, err := updateMailSetting(ctx, r.client, "footer", &mailSettingFooter{
+		Enabled:      false,
+		HTMLContent:  "",
+		PlainContent: "",
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting footer settings",
+			fmt.Sprintf("Unable to reset footer settings, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *footerSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data footerSettingsResourceModel
+
+	o, err := getMailSetting[mailSettingFooter](ctx, r.client, "footer")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing footer settings",
+			fmt.Sprintf("Unable to read footer settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = footerSettingsResourceModel{
+		Enabled:      types.BoolValue(o.Enabled),
+		HTMLContent:  types.StringValue(o.HTMLContent),
+		PlainContent: types.StringValue(o.PlainContent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFooterSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_footer_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFooterSettingsResourceConfig(true, "<p>Sent by Example</p>", "Sent by Example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "html_content", "<p>Sent by Example</p>"),
+					resource.TestCheckResourceAttr(resourceName, "plain_content", "Sent by Example"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "html_content",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFooterSettingsResourceConfig(false, "<p>Sent by Example Inc.</p>", "Sent by Example Inc."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "html_content", "<p>Sent by Example Inc.</p>"),
+					resource.TestCheckResourceAttr(resourceName, "plain_content", "Sent by Example Inc."),
+				),
+			},
+		},
+	})
+}
+
+func testAccFooterSettingsResourceConfig(enabled bool, htmlContent, plainContent string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_footer_settings" "test" {
+  enabled       = %t
+  html_content  = %q
+  plain_content = %q
+}`, enabled, htmlContent, plainContent)
+}
@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &globalUnsubscribesResource{}
+var _ resource.ResourceWithImportState = &globalUnsubscribesResource{}
+var _ resource.ResourceWithIdentity = &globalUnsubscribesResource{}
+
+func newGlobalUnsubscribesResource() resource.Resource {
+	return &globalUnsubscribesResource{}
+}
+
+type globalUnsubscribesResource struct {
+	client *sendgrid.Client
+}
+
+type globalUnsubscribesResourceModel struct {
+	Emails     types.Set    `tfsdk:"emails"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+type globalUnsubscribesIdentityModel struct {
+	Emails     types.List   `tfsdk:"emails"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (m globalUnsubscribesResourceModel) identity() globalUnsubscribesIdentityModel {
+	return globalUnsubscribesIdentityModel{
+		Emails:     types.ListValueMust(types.StringType, m.Emails.Elements()),
+		OnBehalfOf: m.OnBehalfOf,
+	}
+}
+
+func (r *globalUnsubscribesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_unsubscribes"
+	// The identity is the set of addresses, which changes as they are added
+	// and removed in place.
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+func (r *globalUnsubscribesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides addresses in the global unsubscribe list.
+
+Globally unsubscribed addresses no longer receive any email from your account, whatever their unsubscribe group. Use this resource for addresses that must always be suppressed, such as legal or do-not-contact lists.
+
+The resource only manages the addresses listed in ` + "`emails`" + `. Recipients who unsubscribe themselves are left untouched. Destroying this resource removes the listed addresses from the global unsubscribe list.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"emails": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses to add to the global unsubscribe list.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *globalUnsubscribesResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"emails": identityschema.ListAttribute{
+				Description:       "The email addresses that are globally unsubscribed.",
+				ElementType:       types.StringType,
+				RequiredForImport: true,
+			},
+			"on_behalf_of": onBehalfOfIdentityAttribute(),
+		},
+	}
+}
+
+func (r *globalUnsubscribesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *globalUnsubscribesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan globalUnsubscribesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, plan.OnBehalfOf.ValueString())
+
+	if _, err := r.client.AddRecipientAddressesToGlobalSuppressions(ctx, &sendgrid.InputAddRecipientAddressesToGlobalSuppressions{
+		RecipientEmails: flex.ExpandFrameworkStringSet(ctx, plan.Emails),
+	}); err != nil {
+		resp.Diagnostics.AddError(
+			"Creating global unsubscribes",
+			fmt.Sprintf("Unable to add addresses to the global unsubscribe list, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *globalUnsubscribesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state globalUnsubscribesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
+	// Only look up the managed addresses, so that recipients who unsubscribed
+	// themselves do not show up as drift.
+	var emails []string
+	for _, email := range flex.ExpandFrameworkStringSet(ctx, state.Emails) {
+		o, err := r.client.GetGlobalSuppression(ctx, email)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading global unsubscribes",
+				fmt.Sprintf("Unable to read global unsubscribe of %s, got error: %s", email, err),
+			)
+			return
+		}
+		// SendGrid answers with an empty object for addresses that are not
+		// globally unsubscribed.
+		if o.RecipientEmail != "" {
+			emails = append(emails, email)
+		}
+	}
+
+	if len(emails) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, emails)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Emails = set
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *globalUnsubscribesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state globalUnsubscribesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
+	added, removed, err := flex.DiffStringSet(ctx, state.Emails, plan.Emails)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating global unsubscribes",
+			fmt.Sprintf("Unable to compare global unsubscribes, got error: %s", err),
+		)
+		return
+	}
+
+	if len(added) > 0 {
+		if _, err := r.client.AddRecipientAddressesToGlobalSuppressions(ctx, &sendgrid.InputAddRecipientAddressesToGlobalSuppressions{
+			RecipientEmails: added,
+		}); err != nil {
+			resp.Diagnostics.AddError(
+				"Updating global unsubscribes",
+				fmt.Sprintf("Unable to add addresses to the global unsubscribe list, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	for _, email := range removed {
+		if err := r.client.DeleteGlobalSuppression(ctx, email); err != nil {
+			resp.Diagnostics.AddError(
+				"Updating global unsubscribes",
+				fmt.Sprintf("Unable to remove %s from the global unsubscribe list, got error: %s", email, err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *globalUnsubscribesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state globalUnsubscribesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
+	for _, email := range flex.ExpandFrameworkStringSet(ctx, state.Emails) {
+		if err := r.client.DeleteGlobalSuppression(ctx, email); err != nil {
+			resp.Diagnostics.AddError(
+				"Deleting global unsubscribes",
+				fmt.Sprintf("Unable to remove %s from the global unsubscribe list, got error: %s", email, err),
+			)
+			return
+		}
+	}
+}
+
+// ImportState imports the given comma separated addresses, optionally
+// prefixed with a subuser. The global unsubscribe list also holds the
+// addresses of recipients who unsubscribed themselves, so it is never
+// imported as a whole.
+func (r *globalUnsubscribesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		onBehalfOf string
+		emails     []string
+	)
+	if req.ID != "" {
+		var id string
+		onBehalfOf, id = splitOnBehalfOfImportID(req.ID)
+		for _, email := range strings.Split(id, ",") {
+			if email = strings.TrimSpace(email); email != "" {
+				emails = append(emails, email)
+			}
+		}
+	} else {
+		var identity globalUnsubscribesIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(identity.Emails.ElementsAs(ctx, &emails, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		onBehalfOf = identity.OnBehalfOf.ValueString()
+	}
+	if len(emails) == 0 {
+		resp.Diagnostics.AddError(
+			"Importing global unsubscribes",
+			fmt.Sprintf("Expected import ID of the form email[,email...] or subuser/email[,email...], got: %q", req.ID),
+		)
+		return
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, emails)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read drops the addresses that are not globally unsubscribed.
+	data := globalUnsubscribesResourceModel{
+		Emails: set,
+	}
+	if onBehalfOf != "" {
+		data.OnBehalfOf = types.StringValue(onBehalfOf)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGlobalUnsubscribesResource(t *testing.T) {
+	resourceName := "sendgrid_global_unsubscribes.test"
+
+	prefix := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+	legal := prefix + "-legal@example.com"
+	dnc := prefix + "-dnc@example.com"
+	abuse := prefix + "-abuse@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGlobalUnsubscribesResourceConfig(legal, dnc),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "emails.*", legal),
+					resource.TestCheckTypeSetElemAttr(resourceName, "emails.*", dnc),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: legal + "," + dnc,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+					if got := states[0].Attributes["emails.#"]; got != "2" {
+						return fmt.Errorf("expected 2 emails, got %s", got)
+					}
+					return nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccGlobalUnsubscribesResourceConfig(dnc, abuse),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "emails.*", dnc),
+					resource.TestCheckTypeSetElemAttr(resourceName, "emails.*", abuse),
+				),
+			},
+		},
+	})
+}
+
+func testAccGlobalUnsubscribesResourceConfig(email1, email2 string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_global_unsubscribes" "test" {
+  emails = [%q, %q]
+}
+`, email1, email2)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &googleAnalyticsSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &googleAnalyticsSettingsDataSource{}
+)
+
+func newGoogleAnalyticsSettingsDataSource() datasource.DataSource {
+	return &googleAnalyticsSettingsDataSource{}
+}
+
+type googleAnalyticsSettingsDataSource struct {
+	client *sendgrid.Client
+}
+
+type googleAnalyticsSettingsDataSourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	UTMSource   types.String `tfsdk:"utm_source"`
+	UTMMedium   types.String `tfsdk:"utm_medium"`
+	UTMTerm     types.String `tfsdk:"utm_term"`
+	UTMContent  types.String `tfsdk:"utm_content"`
+	UTMCampaign types.String `tfsdk:"utm_campaign"`
+}
+
+func (d *googleAnalyticsSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_analytics_settings"
+}
+
+func (d *googleAnalyticsSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *googleAnalyticsSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Google Analytics adds UTM parameters to the links of your emails, so that you can track the traffic they bring to your website in Google Analytics.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if Google Analytics is enabled.",
+				Computed:            true,
+			},
+			"utm_source": schema.StringAttribute{
+				MarkdownDescription: "The name of the referrer source, such as `sendgrid`.",
+				Computed:            true,
+			},
+			"utm_medium": schema.StringAttribute{
+				MarkdownDescription: "The name of the marketing medium, such as `email`.",
+				Computed:            true,
+			},
+			"utm_term": schema.StringAttribute{
+				MarkdownDescription: "The paid keywords of the campaign.",
+				Computed:            true,
+			},
+			"utm_content": schema.StringAttribute{
+				MarkdownDescription: "Used to differentiate ads in the same campaign.",
+				Computed:            true,
+			},
+			"utm_campaign": schema.StringAttribute{
+				MarkdownDescription: "The name of the campaign.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *googleAnalyticsSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data googleAnalyticsSettingsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := d.client.GetGoogleAnalyticsSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading Google Analytics settings",
+			fmt.Sprintf("Unable to get Google Analytics settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = googleAnalyticsSettingsDataSourceModel{
+		Enabled:     types.BoolValue(o.Enabled),
+		UTMSource:   types.StringValue(o.UTMSource),
+		UTMMedium:   types.StringValue(o.UTMMedium),
+		UTMTerm:     types.StringValue(o.UTMTerm),
+		UTMContent:  types.StringValue(o.UTMContent),
+		UTMCampaign: types.StringValue(o.UTMCampaign),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
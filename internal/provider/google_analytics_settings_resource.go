@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &googleAnalyticsSettingsResource{}
+var _ resource.ResourceWithImportState = &googleAnalyticsSettingsResource{}
+
+func newGoogleAnalyticsSettingsResource() resource.Resource {
+	return &googleAnalyticsSettingsResource{}
+}
+
+type googleAnalyticsSettingsResource struct {
+	client *sendgrid.Client
+}
+
+type googleAnalyticsSettingsResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	UTMSource   types.String `tfsdk:"utm_source"`
+	UTMMedium   types.String `tfsdk:"utm_medium"`
+	UTMTerm     types.String `tfsdk:"utm_term"`
+	UTMContent  types.String `tfsdk:"utm_content"`
+	UTMCampaign types.String `tfsdk:"utm_campaign"`
+}
+
+func (r *googleAnalyticsSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_analytics_settings"
+}
+
+func (r *googleAnalyticsSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Google Analytics adds UTM parameters to the links of your emails, so that you can track the traffic they bring to your website in Google Analytics.
+
+Attributes that are not configured keep their current value, and cannot be cleared once set. Destroying this resource disables the setting and leaves the other attributes unchanged.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if Google Analytics is enabled.",
+				Required:            true,
+			},
+			"utm_source": schema.StringAttribute{
+				MarkdownDescription: "The name of the referrer source, such as `sendgrid`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"utm_medium": schema.StringAttribute{
+				MarkdownDescription: "The name of the marketing medium, such as `email`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"utm_term": schema.StringAttribute{
+				MarkdownDescription: "The paid keywords of the campaign.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"utm_content": schema.StringAttribute{
+				MarkdownDescription: "Used to differentiate ads in the same campaign.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"utm_campaign": schema.StringAttribute{
+				MarkdownDescription: "The name of the campaign.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *googleAnalyticsSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *googleAnalyticsSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan googleAnalyticsSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.UpdateGoogleAnalyticsSettings(ctx, &sendgrid.InputUpdateGoogleAnalyticsSettings{
+		Enabled:     plan.Enabled.ValueBool(),
+		UTMSource:   plan.UTMSource.ValueString(),
+		UTMMedium:   plan.UTMMedium.ValueString(),
+		UTMTerm:     plan.UTMTerm.ValueString(),
+		UTMContent:  plan.UTMContent.ValueString(),
+		UTMCampaign: plan.UTMCampaign.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating Google Analytics settings",
+			fmt.Sprintf("Unable to update Google Analytics settings, got error: %s", err),
+		)
+		return
+	}
+
+	plan = googleAnalyticsSettingsResourceModel{
+		Enabled:     types.BoolValue(o.Enabled),
+		UTMSource:   types.StringValue(o.UTMSource),
+		UTMMedium:   types.StringValue(o.UTMMedium),
+		UTMTerm:     types.StringValue(o.UTMTerm),
+		UTMContent:  types.StringValue(o.UTMContent),
+		UTMCampaign: types.StringValue(o.UTMCampaign),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *googleAnalyticsSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state googleAnalyticsSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.GetGoogleAnalyticsSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading Google Analytics settings",
+			fmt.Sprintf("Unable to read Google Analytics settings, got error: %s", err),
+		)
+		return
+	}
+
+	state = googleAnalyticsSettingsResourceModel{
+		Enabled:     types.BoolValue(o.Enabled),
+		UTMSource:   types.StringValue(o.UTMSource),
+		UTMMedium:   types.StringValue(o.UTMMedium),
+		UTMTerm:     types.StringValue(o.UTMTerm),
+		UTMContent:  types.StringValue(o.UTMContent),
+		UTMCampaign: types.StringValue(o.UTMCampaign),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *googleAnalyticsSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data googleAnalyticsSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.UpdateGoogleAnalyticsSettings(ctx, &sendgrid.InputUpdateGoogleAnalyticsSettings{
+		Enabled:     data.Enabled.ValueBool(),
+		UTMSource:   data.UTMSource.ValueString(),
+		UTMMedium:   data.UTMMedium.ValueString(),
+		UTMTerm:     data.UTMTerm.ValueString(),
+		UTMContent:  data.UTMContent.ValueString(),
+		UTMCampaign: data.UTMCampaign.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating Google Analytics settings",
+			fmt.Sprintf("Unable to update Google Analytics settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = googleAnalyticsSettingsResourceModel{
+		Enabled:     types.BoolValue(o.Enabled),
+		UTMSource:   types.StringValue(o.UTMSource),
+		UTMMedium:   types.StringValue(o.UTMMedium),
+		UTMTerm:     types.StringValue(o.UTMTerm),
+		UTMContent:  types.StringValue(o.UTMContent),
+		UTMCampaign: types.StringValue(o.UTMCampaign),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *googleAnalyticsSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state googleAnalyticsSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tracking settings cannot be deleted, so disable the setting instead.
+	_, err := r.client.UpdateGoogleAnalyticsSettings(ctx, &sendgrid.InputUpdateGoogleAnalyticsSettings{
+		Enabled: false,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting Google Analytics settings",
+			fmt.Sprintf("Unable to disable Google Analytics settings, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *googleAnalyticsSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data googleAnalyticsSettingsResourceModel
+
+	o, err := r.client.GetGoogleAnalyticsSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing Google Analytics settings",
+			fmt.Sprintf("Unable to read Google Analytics settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = googleAnalyticsSettingsResourceModel{
+		Enabled:     types.BoolValue(o.Enabled),
+		UTMSource:   types.StringValue(o.UTMSource),
+		UTMMedium:   types.StringValue(o.UTMMedium),
+		UTMTerm:     types.StringValue(o.UTMTerm),
+		UTMContent:  types.StringValue(o.UTMContent),
+		UTMCampaign: types.StringValue(o.UTMCampaign),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
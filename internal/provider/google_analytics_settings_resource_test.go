@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGoogleAnalyticsSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_google_analytics_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGoogleAnalyticsSettingsResourceConfig(true, "sendgrid", "email", "shoes", "banner", "spring"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "utm_source", "sendgrid"),
+					resource.TestCheckResourceAttr(resourceName, "utm_medium", "email"),
+					resource.TestCheckResourceAttr(resourceName, "utm_term", "shoes"),
+					resource.TestCheckResourceAttr(resourceName, "utm_content", "banner"),
+					resource.TestCheckResourceAttr(resourceName, "utm_campaign", "spring"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importClickTrackingSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "utm_source",
+			},
+			// Update and Read testing
+			{
+				Config: testAccGoogleAnalyticsSettingsResourceConfig(false, "sendgrid.com", "newsletter", "boots", "footer", "summer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "utm_source", "sendgrid.com"),
+					resource.TestCheckResourceAttr(resourceName, "utm_medium", "newsletter"),
+					resource.TestCheckResourceAttr(resourceName, "utm_term", "boots"),
+					resource.TestCheckResourceAttr(resourceName, "utm_content", "footer"),
+					resource.TestCheckResourceAttr(resourceName, "utm_campaign", "summer"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGoogleAnalyticsSettingsResourceConfig(enabled bool, utmSource, utmMedium, utmTerm, utmContent, utmCampaign string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_google_analytics_settings" "test" {
+  enabled      = %t
+  utm_source   = %q
+  utm_medium   = %q
+  utm_term     = %q
+  utm_content  = %q
+  utm_campaign = %q
+}
+`, enabled, utmSource, utmMedium, utmTerm, utmContent, utmCampaign)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resource identities let `import` blocks address an object with typed
+// attributes, e.g. `identity = { username = "..." }`, instead of the ad-hoc
+// import ID of each resource. Import by ID keeps working alongside them.
+
+// onBehalfOfIdentityAttribute is the identity counterpart of
+// onBehalfOfAttribute.
+func onBehalfOfIdentityAttribute() identityschema.StringAttribute {
+	return identityschema.StringAttribute{
+		Description:       "The username of the subuser the resource is managed on behalf of, if any.",
+		OptionalForImport: true,
+	}
+}
+
+// importIDOrIdentity returns the import ID when importing by ID, or the string
+// attribute attr of the identity when importing by identity.
+func importIDOrIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attr string) string {
+	if req.ID != "" {
+		return req.ID
+	}
+
+	var v types.String
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attr), &v)...)
+	return v.ValueString()
+}
+
+// importOnBehalfOfID returns the subuser and the ID of a resource that can be
+// managed on behalf of a subuser, from either an import ID of the form
+// subuser/id or an identity with the on_behalf_of and attr attributes.
+func importOnBehalfOfID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attr string) (onBehalfOf, id string) {
+	if req.ID != "" {
+		return splitOnBehalfOfImportID(req.ID)
+	}
+
+	var subuser types.String
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("on_behalf_of"), &subuser)...)
+	return subuser.ValueString(), importIDOrIdentity(ctx, req, resp, attr)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestAccResourceIdentity imports each resource by the identity it stored on
+// create, and expects the import to plan no changes.
+func TestAccResourceIdentity(t *testing.T) {
+	name := func() string {
+		return fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	}
+	email := func() string {
+		return fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+	}
+	ips := escapesStrings([]string{os.Getenv("IP_ADDRESS")})
+
+	tests := []struct {
+		resourceName string
+		config       string
+	}{
+		{"sendgrid_alert.test", testAccAlertResourceConfig(email(), 90)},
+		{"sendgrid_design.test", testAccDesignResourceConfig(name(), name())},
+		{"sendgrid_ip_pool.test", testAccIPPoolResourceConfig(name())},
+		{"sendgrid_link_branding.test", testAccLinkBrandingResourceConfig(name()+".com", false)},
+		{"sendgrid_sender_verification.test", testAccSenderVerificationResourceConfig(name(), email(), name(), email(), name(), name(), name(), name())},
+		{"sendgrid_sso_teammate.test", testAccSSOTeammateResourceConfig(email(), name(), name())},
+		{"sendgrid_subuser_credits.test", testAccSubuserCreditsResourceConfig(name(), "\n  type = \"unlimited\"\n")},
+		{"sendgrid_subuser_monitor.test", testAccSubuserMonitorResourceConfig(name(), email(), name(), email(), 10)},
+		{"sendgrid_subuser_whitelabel_domain.test", testAccSubuserWhitelabelDomainResourceConfig(name()+".com", name(), email(), name(), ips)},
+		{"sendgrid_template_version.test", testAccTemplateVersionResourceConfig(name(), name(), name())},
+		{"sendgrid_unsubscribe_group.test", testAccUnsubscribeGroupResourceConfig(name(), name(), false)},
+		{"sendgrid_unsubscribe_group_suppressions.test", testAccUnsubscribeGroupSuppressionsResourceConfig(name(), `["legal@example.com", "dnc@example.com"]`)},
+		{"sendgrid_global_unsubscribes.test", testAccGlobalUnsubscribesResourceConfig(email(), email())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resourceName, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_12_0),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: tt.config,
+					},
+					// ImportState by identity testing
+					{
+						ResourceName:    tt.resourceName,
+						ImportState:     true,
+						ImportStateKind: resource.ImportBlockWithResourceIdentity,
+					},
+				},
+			})
+		})
+	}
+}
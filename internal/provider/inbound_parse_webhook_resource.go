@@ -7,10 +7,12 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
 )
@@ -18,6 +20,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &inboundParseWebhookResource{}
 var _ resource.ResourceWithImportState = &inboundParseWebhookResource{}
+var _ resource.ResourceWithIdentity = &inboundParseWebhookResource{}
 
 func newInboundParseWebhookResource() resource.Resource {
 	return &inboundParseWebhookResource{}
@@ -34,6 +37,16 @@ type inboundParseWebhookResourceModel struct {
 	SendRaw   types.Bool   `tfsdk:"send_raw"`
 }
 
+type inboundParseWebhookIdentityModel struct {
+	Hostname types.String `tfsdk:"hostname"`
+}
+
+func (m inboundParseWebhookResourceModel) identity() inboundParseWebhookIdentityModel {
+	return inboundParseWebhookIdentityModel{
+		Hostname: m.Hostname,
+	}
+}
+
 func (r *inboundParseWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_inbound_parse_webhook"
 }
@@ -49,8 +62,11 @@ To begin processing email using SendGrid's Inbound Parse Webhook, you will have
 		`,
 		Attributes: map[string]schema.Attribute{
 			"hostname": schema.StringAttribute{
-				MarkdownDescription: "A specific and unique domain or subdomain that you have created to use exclusively to parse your incoming email. For example, `parse.yourdomain.com`.",
+				MarkdownDescription: "A specific and unique domain or subdomain that you have created to use exclusively to parse your incoming email. For example, `parse.yourdomain.com`. Changing it creates a new inbound parse webhook.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"url": schema.StringAttribute{
 				MarkdownDescription: "The public URL where you would like SendGrid to POST the data parsed from your email. Any emails sent with the given hostname provided (whose MX records have been updated to point to SendGrid) will be parsed and POSTed to this URL.",
@@ -72,6 +88,17 @@ To begin processing email using SendGrid's Inbound Parse Webhook, you will have
 	}
 }
 
+func (r *inboundParseWebhookResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"hostname": identityschema.StringAttribute{
+				Description:       "The hostname of the inbound parse webhook.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *inboundParseWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -105,9 +132,7 @@ func (r *inboundParseWebhookResource) Create(ctx context.Context, req resource.C
 		SendRaw:   plan.SendRaw.ValueBool(),
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateInboundParseWebhook(context.TODO(), input)
-	})
+	o, err := r.client.CreateInboundParseWebhook(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating inbound parse webhook",
@@ -116,15 +141,6 @@ func (r *inboundParseWebhookResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateInboundParseWebhook)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating inbound parse webhook",
-			"Failed to assert type *sendgrid.OutputCreateInboundParseWebhook",
-		)
-		return
-	}
-
 	// NOTE: In the response of the inbound parse webhook creation API,
 	//       spam_check and send_raw always return false.
 	//       Therefore, execute the inbound parse webhook acquisition API to acquire the current settings of spam_check and send_raw.
@@ -149,6 +165,7 @@ func (r *inboundParseWebhookResource) Create(ctx context.Context, req resource.C
 		URL: plan.URL,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -178,6 +195,7 @@ func (r *inboundParseWebhookResource) Read(ctx context.Context, req resource.Rea
 		SendRaw:   types.BoolValue(o.SendRaw),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -219,6 +237,7 @@ func (r *inboundParseWebhookResource) Update(ctx context.Context, req resource.U
 		SendRaw:   types.BoolValue(o.SendRaw),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -232,9 +251,7 @@ func (r *inboundParseWebhookResource) Delete(ctx context.Context, req resource.D
 	}
 
 	hostname := data.Hostname.ValueString()
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteInboundParseWebhook(ctx, hostname)
-	})
+	err := r.client.DeleteInboundParseWebhook(ctx, hostname)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting inbound parse webhook",
@@ -245,9 +262,10 @@ func (r *inboundParseWebhookResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *inboundParseWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	hostname := req.ID
-
-	resource.ImportStatePassthroughID(ctx, path.Root("hostname"), req, resp)
+	hostname := importIDOrIdentity(ctx, req, resp, "hostname")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	o, err := r.client.GetInboundParseWebhook(ctx, hostname)
 	if err != nil {
@@ -265,6 +283,7 @@ func (r *inboundParseWebhookResource) ImportState(ctx context.Context, req resou
 		SendRaw:   types.BoolValue(o.SendRaw),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, d.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &invalidEmailsDataSource{}
+	_ datasource.DataSourceWithConfigure = &invalidEmailsDataSource{}
+)
+
+func newInvalidEmailsDataSource() datasource.DataSource {
+	return &invalidEmailsDataSource{}
+}
+
+type invalidEmailsDataSource struct {
+	client *sendgrid.Client
+}
+
+type invalidEmailsDataSourceModel struct {
+	StartTime     types.Int64          `tfsdk:"start_time"`
+	EndTime       types.Int64          `tfsdk:"end_time"`
+	Email         types.String         `tfsdk:"email"`
+	InvalidEmails []invalidEmailsModel `tfsdk:"invalid_emails"`
+}
+
+type invalidEmailsModel struct {
+	Email   types.String `tfsdk:"email"`
+	Created types.Int64  `tfsdk:"created"`
+	Reason  types.String `tfsdk:"reason"`
+}
+
+func (d *invalidEmailsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invalid_emails"
+}
+
+func (d *invalidEmailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *invalidEmailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the invalid emails of your SendGrid account.
+
+Invalid emails are addresses that are malformed or whose domain does not exist. SendGrid suppresses them until they are removed from the list.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"invalid_emails": schema.ListNestedAttribute{
+				MarkdownDescription: "The invalid emails matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the invalid email.",
+							Computed:            true,
+						},
+						"created": schema.Int64Attribute{
+							MarkdownDescription: "The Unix timestamp of when the invalid email was created.",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "The reason the address is invalid.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, suppressionListFilterAttributes("invalid emails"))
+}
+
+func (d *invalidEmailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data invalidEmailsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listAllSuppressions(ctx, suppressionListOptions(data.StartTime, data.EndTime, data.Email), d.client.GetInvalidEmails)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading invalid emails",
+			fmt.Sprintf("Unable to list invalid emails, got error: %s", err),
+		)
+		return
+	}
+
+	data.InvalidEmails = make([]invalidEmailsModel, 0, len(items))
+	for _, v := range items {
+		data.InvalidEmails = append(data.InvalidEmails, invalidEmailsModel{
+			Email:   types.StringValue(v.Email),
+			Created: types.Int64Value(v.Created),
+			Reason:  types.StringValue(v.Reason),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
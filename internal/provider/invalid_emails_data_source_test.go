@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/kenzo0107/sendgrid"
+)
+
+func TestAccInvalidEmailsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "sendgrid_invalid_emails" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sendgrid_invalid_emails.test", "invalid_emails.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInvalidEmailsDataSource_filters(t *testing.T) {
+	server := testAccOffline(t)
+
+	server.AddInvalidEmail(sendgrid.InvalidEmail{Email: "old-invalid_emails@example.com", Created: 1700000000, Reason: "Mail domain mentioned in email address is unknown"})
+	server.AddInvalidEmail(sendgrid.InvalidEmail{Email: "new-invalid_emails@example.com", Created: 1800000000, Reason: "Mail domain mentioned in email address is unknown"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccInvalidEmailsDataSourceFiltersConfig(1750000000, 1850000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_invalid_emails.test", "invalid_emails.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_invalid_emails.test", "invalid_emails.0.email", "new-invalid_emails@example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_invalid_emails.test", "invalid_emails.0.created", "1800000000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInvalidEmailsDataSourceFiltersConfig(startTime, endTime int64) string {
+	return fmt.Sprintf(`
+data "sendgrid_invalid_emails" "test" {
+  start_time = %d
+  end_time   = %d
+}
+`, startTime, endTime)
+}
@@ -0,0 +1,419 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ipAccessAllowListResource{}
+var _ resource.ResourceWithImportState = &ipAccessAllowListResource{}
+var _ resource.ResourceWithModifyPlan = &ipAccessAllowListResource{}
+
+func newIPAccessAllowListResource() resource.Resource {
+	return &ipAccessAllowListResource{}
+}
+
+type ipAccessAllowListResource struct {
+	client *sendgrid.Client
+}
+
+type ipAccessAllowListResourceModel struct {
+	IPs       types.Set    `tfsdk:"ips"`
+	CurrentIP types.String `tfsdk:"current_ip"`
+}
+
+func (r *ipAccessAllowListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_access_allow_list"
+}
+
+func (r *ipAccessAllowListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the IP Access Management allow list of your SendGrid account.
+
+IP Access Management restricts the IP addresses that can log in to SendGrid or call its API. Once the allow list has any entry, requests from every other IP address are refused, including the ones Terraform makes.
+
+This resource manages the full allow list: entries that are not listed in ` + "`ips`" + ` are removed. To avoid locking Terraform out, plans that change ` + "`ips`" + ` fail when it does not cover the IP address Terraform reaches SendGrid from. That address is taken from ` + "`current_ip`" + ` or, when it is not set, guessed from the most recent allowed access activity of the account. The guess assumes that access came from Terraform, so a teammate logging in from elsewhere at the same time can make it wrong, either failing a valid plan or letting through one that locks Terraform out. Set ` + "`current_ip`" + ` for a reliable check.
+
+Destroying this resource removes every entry, which allows access from any IP address.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"ips": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IP addresses and CIDR blocks allowed to access the account.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringIPAddressOrCIDR()),
+				},
+			},
+			"current_ip": schema.StringAttribute{
+				MarkdownDescription: "The IP address Terraform reaches SendGrid from, which `ips` must cover. When omitted, it is guessed from the IP address of the most recent allowed access activity of the account, on a best-effort basis: that access may have come from someone else. Set it for a reliable lockout check.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringIPAddress(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ipAccessAllowListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ipAccessAllowListResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Removing the allow list cannot lock anyone out.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ipAccessAllowListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Terraform plans again with the final values before applying.
+	if plan.IPs.IsUnknown() || plan.CurrentIP.IsUnknown() {
+		return
+	}
+
+	// Keeping the allow list as it is cannot lock anyone out either.
+	if !req.State.Raw.IsNull() {
+		var state ipAccessAllowListResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || plan.IPs.Equal(state.IPs) {
+			return
+		}
+	}
+
+	currentIP := plan.CurrentIP.ValueString()
+	if currentIP == "" {
+		ip, err := r.latestAllowedAccessIP(ctx)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("current_ip"),
+				"Unable to determine the current IP address",
+				fmt.Sprintf("Unable to read the access activity to find the IP address Terraform reaches SendGrid from, got error: %s. Set current_ip to skip the lookup.", err),
+			)
+			return
+		}
+		currentIP = ip
+	}
+
+	ips := flex.ExpandFrameworkStringSet(ctx, plan.IPs)
+	if !allowListContains(ips, currentIP) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ips"),
+			"Allow list would lock out the current IP address",
+			fmt.Sprintf("Terraform reaches SendGrid from %s, which is not covered by ips. Applying this allow list would refuse every further request from it. Add %s, or a CIDR block containing it, to ips.", currentIP, currentIP),
+		)
+	}
+}
+
+func (r *ipAccessAllowListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ipAccessAllowListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleIDs, err := r.allowListRuleIDs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating ip access allow list",
+			fmt.Sprintf("Unable to read ip access allow list, got error: %s", err),
+		)
+		return
+	}
+
+	// The allow list is managed as a whole, so entries added outside of
+	// Terraform are removed.
+	ips := flex.ExpandFrameworkStringSet(ctx, plan.IPs)
+	var addIPs, removeIPs []string
+	for _, ip := range ips {
+		if _, ok := ruleIDs[ip]; !ok {
+			addIPs = append(addIPs, ip)
+		}
+	}
+	for ip := range ruleIDs {
+		if !slices.Contains(ips, ip) {
+			removeIPs = append(removeIPs, ip)
+		}
+	}
+
+	if err := r.updateAllowList(ctx, addIPs, removeIPs); err != nil {
+		resp.Diagnostics.AddError(
+			"Creating ip access allow list",
+			fmt.Sprintf("Unable to update ip access allow list, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipAccessAllowListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ipAccessAllowListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.GetAllowedIPs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading ip access allow list",
+			fmt.Sprintf("Unable to read ip access allow list, got error: %s", err),
+		)
+		return
+	}
+
+	// An empty allow list means IP Access Management is off.
+	if len(o.Result) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var ips []attr.Value
+	for _, a := range o.Result {
+		ips = append(ips, types.StringValue(a.IP))
+	}
+
+	state = ipAccessAllowListResourceModel{
+		IPs:       types.SetValueMust(types.StringType, ips),
+		CurrentIP: state.CurrentIP,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipAccessAllowListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ipAccessAllowListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addIPs, removeIPs, err := flex.DiffStringSet(ctx, state.IPs, data.IPs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating ip access allow list",
+			fmt.Sprintf("Unable to diff IP sets, got error: %s", err),
+		)
+		return
+	}
+
+	if err := r.updateAllowList(ctx, addIPs, removeIPs); err != nil {
+		resp.Diagnostics.AddError(
+			"Updating ip access allow list",
+			fmt.Sprintf("Unable to update ip access allow list, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ipAccessAllowListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ipAccessAllowListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleIDs, err := r.allowListRuleIDs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting ip access allow list",
+			fmt.Sprintf("Unable to read ip access allow list, got error: %s", err),
+		)
+		return
+	}
+	if len(ruleIDs) == 0 {
+		return
+	}
+
+	var ids []int64
+	for _, id := range ruleIDs {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	if err := r.client.RemoveIPsFromAllowList(ctx, &sendgrid.InputRemoveIPsFromAllowList{
+		IDs: ids,
+	}); err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting ip access allow list",
+			fmt.Sprintf("Unable to remove IPs from ip access allow list, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *ipAccessAllowListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	o, err := r.client.GetAllowedIPs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing ip access allow list",
+			fmt.Sprintf("Unable to read ip access allow list, got error: %s", err),
+		)
+		return
+	}
+
+	var ips []attr.Value
+	for _, a := range o.Result {
+		ips = append(ips, types.StringValue(a.IP))
+	}
+
+	data := ipAccessAllowListResourceModel{
+		IPs:       types.SetValueMust(types.StringType, ips),
+		CurrentIP: types.StringNull(),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// updateAllowList adds addIPs to the allow list before removing removeIPs, so
+// that replacing an entry never leaves a gap in which Terraform is locked out.
+func (r *ipAccessAllowListResource) updateAllowList(ctx context.Context, addIPs, removeIPs []string) error {
+	if len(addIPs) > 0 {
+		input := &sendgrid.InputAddIPsToAllowList{}
+		for _, ip := range addIPs {
+			input.IPs = append(input.IPs, sendgrid.AllowListIP{IP: ip})
+		}
+		if _, err := r.client.AddIPsToAllowList(ctx, input); err != nil {
+			return fmt.Errorf("adding %s: %w", flex.QuoteAndJoin(addIPs), err)
+		}
+	}
+
+	if len(removeIPs) == 0 {
+		return nil
+	}
+
+	ruleIDs, err := r.allowListRuleIDs(ctx)
+	if err != nil {
+		return err
+	}
+	var ids []int64
+	for _, ip := range removeIPs {
+		// Entries already removed outside of Terraform need no request.
+		if id, ok := ruleIDs[ip]; ok {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := r.client.RemoveIPsFromAllowList(ctx, &sendgrid.InputRemoveIPsFromAllowList{
+		IDs: ids,
+	}); err != nil {
+		return fmt.Errorf("removing %s: %w", flex.QuoteAndJoin(removeIPs), err)
+	}
+	return nil
+}
+
+// allowListRuleIDs returns the rule ID of each entry of the allow list, keyed
+// by its IP address or CIDR block.
+func (r *ipAccessAllowListResource) allowListRuleIDs(ctx context.Context) (map[string]int64, error) {
+	o, err := r.client.GetAllowedIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleIDs := make(map[string]int64, len(o.Result))
+	for _, a := range o.Result {
+		ruleIDs[a.IP] = a.ID
+	}
+	return ruleIDs, nil
+}
+
+// latestAllowedAccessIP returns the IP address of the most recent access to
+// the account that was let through. Terraform has just read the account to
+// plan, so this is normally the address it reaches SendGrid from, but any
+// teammate accessing the account at the same time can shadow it.
+func (r *ipAccessAllowListResource) latestAllowedAccessIP(ctx context.Context) (string, error) {
+	o, err := r.client.GetAccessActivities(ctx, &sendgrid.InputGetAccessActivities{
+		Limit: 20,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var latest *sendgrid.AccessActivity
+	for _, a := range o.Result {
+		if a.Allowed && (latest == nil || a.LastAt > latest.LastAt) {
+			latest = a
+		}
+	}
+	if latest == nil {
+		return "", fmt.Errorf("no allowed access activity found")
+	}
+	return latest.IP, nil
+}
+
+// allowListContains reports whether ip matches one of the addresses or falls
+// within one of the CIDR blocks in ips.
+func allowListContains(ips []string, ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	for _, v := range ips {
+		if p, err := netip.ParsePrefix(v); err == nil {
+			if p.Contains(addr) {
+				return true
+			}
+			continue
+		}
+		if a, err := netip.ParseAddr(v); err == nil && a == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// The allow list tests only run against the offline API: the IP address a
+// real account would see for the test runner is not known up front, and a
+// mistake would lock the account out.
+
+func TestAccIPAccessAllowListResource(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_ip_access_allow_list.test"
+
+	var requests int
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIPAccessAllowListResourceConfig("127.0.0.1", "198.51.100.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ips.*", "127.0.0.1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ips.*", "198.51.100.0/24"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+					if got := states[0].Attributes["ips.#"]; got != "2" {
+						return fmt.Errorf("expected 2 ips, got %s", got)
+					}
+					return nil
+				},
+			},
+			// Update and Read testing: the loopback CIDR block replaces the
+			// address Terraform is calling from.
+			{
+				Config: testAccIPAccessAllowListResourceConfig("127.0.0.0/8", "203.0.113.7"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ips.*", "127.0.0.0/8"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ips.*", "203.0.113.7"),
+				),
+			},
+			// Plans that keep ips as they are do not look up the access
+			// activity, which may have been shadowed by someone else.
+			{
+				PreConfig: func() {
+					requests = len(server.Requests())
+				},
+				Config: testAccIPAccessAllowListResourceConfig("127.0.0.0/8", "203.0.113.7"),
+				Check: func(s *terraform.State) error {
+					for _, r := range server.Requests()[requests:] {
+						if r.Path == "/v3/access_settings/activity" {
+							return fmt.Errorf("unexpected %s %s when ips are unchanged", r.Method, r.Path)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccIPAccessAllowListResourceLockout(t *testing.T) {
+	testAccOffline(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIPAccessAllowListResourceConfig("198.51.100.0/24", "203.0.113.7"),
+				ExpectError: regexp.MustCompile(`Allow list would lock out the current IP address`),
+			},
+			{
+				Config: `
+resource "sendgrid_ip_access_allow_list" "test" {
+  ips        = ["203.0.113.7"]
+  current_ip = "198.51.100.1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Terraform reaches SendGrid from 198\.51\.100\.1`),
+			},
+		},
+	})
+}
+
+func testAccIPAccessAllowListResourceConfig(ip1, ip2 string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_ip_access_allow_list" "test" {
+  ips = [%q, %q]
+}
+`, ip1, ip2)
+}
+
+func TestAllowListContains(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ips  []string
+		ip   string
+		want bool
+	}{
+		"exact address": {
+			ips:  []string{"192.0.2.1"},
+			ip:   "192.0.2.1",
+			want: true,
+		},
+		"address within a CIDR block": {
+			ips:  []string{"192.0.2.1", "198.51.100.0/24"},
+			ip:   "198.51.100.42",
+			want: true,
+		},
+		"address outside every entry": {
+			ips:  []string{"192.0.2.1", "198.51.100.0/24"},
+			ip:   "203.0.113.7",
+			want: false,
+		},
+		"IPv6 address within a CIDR block": {
+			ips:  []string{"2001:db8::/32"},
+			ip:   "2001:db8::1",
+			want: true,
+		},
+		"invalid address": {
+			ips:  []string{"192.0.2.1"},
+			ip:   "not-an-ip",
+			want: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := allowListContains(test.ips, test.ip); got != test.want {
+				t.Errorf("allowListContains(%v, %q) = %v, want %v", test.ips, test.ip, got, test.want)
+			}
+		})
+	}
+}
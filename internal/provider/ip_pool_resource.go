@@ -8,8 +8,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
@@ -19,6 +19,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ipPoolResource{}
 var _ resource.ResourceWithImportState = &ipPoolResource{}
+var _ resource.ResourceWithIdentity = &ipPoolResource{}
 
 func newIPPoolResource() resource.Resource {
 	return &ipPoolResource{}
@@ -33,8 +34,20 @@ type ipPoolResourceModel struct {
 	IPs  types.List   `tfsdk:"ips"`
 }
 
+type ipPoolIdentityModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (m ipPoolResourceModel) identity() ipPoolIdentityModel {
+	return ipPoolIdentityModel{
+		Name: m.Name,
+	}
+}
+
 func (r *ipPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_ip_pool"
+	// An IP pool is renamed in place, so its identity changes with its name.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *ipPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -58,6 +71,17 @@ IP Pools allow you to group your dedicated SendGrid IP addresses together. For e
 	}
 }
 
+func (r *ipPoolResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the IP pool.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ipPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -82,10 +106,8 @@ func (r *ipPoolResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateIPPool(ctx, &sendgrid.InputCreateIPPool{
-			Name: plan.Name.ValueString(),
-		})
+	o, err := r.client.CreateIPPool(ctx, &sendgrid.InputCreateIPPool{
+		Name: plan.Name.ValueString(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -95,15 +117,6 @@ func (r *ipPoolResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateIPPool)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating ip pool",
-			"Failed to assert type *sendgrid.OutputCreateIPPool",
-		)
-		return
-	}
-
 	var ips []string
 	diags := plan.IPs.ElementsAs(ctx, &ips, false)
 	if diags.HasError() {
@@ -115,10 +128,8 @@ func (r *ipPoolResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	for _, ip := range ips {
-		_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-			return r.client.AddIPToPool(ctx, o.Name, &sendgrid.InputAddIPToPool{
-				IP: ip,
-			})
+		_, err := r.client.AddIPToPool(ctx, o.Name, &sendgrid.InputAddIPToPool{
+			IP: ip,
 		})
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -134,6 +145,7 @@ func (r *ipPoolResource) Create(ctx context.Context, req resource.CreateRequest,
 		IPs:  plan.IPs,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -171,6 +183,7 @@ func (r *ipPoolResource) Read(ctx context.Context, req resource.ReadRequest, res
 		IPs:  types.ListValueMust(types.StringType, ipList),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -207,10 +220,8 @@ func (r *ipPoolResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 	for _, ip := range addIPs {
-		_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-			return r.client.AddIPToPool(ctx, o.Name, &sendgrid.InputAddIPToPool{
-				IP: ip,
-			})
+		_, err := r.client.AddIPToPool(ctx, o.Name, &sendgrid.InputAddIPToPool{
+			IP: ip,
 		})
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -221,9 +232,7 @@ func (r *ipPoolResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 	for _, ip := range removeIPs {
-		_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-			return nil, r.client.RemoveIPFromPool(ctx, o.Name, ip)
-		})
+		err := r.client.RemoveIPFromPool(ctx, o.Name, ip)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Removing IP from pool",
@@ -238,6 +247,7 @@ func (r *ipPoolResource) Update(ctx context.Context, req resource.UpdateRequest,
 		IPs:  data.IPs,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -252,9 +262,7 @@ func (r *ipPoolResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	name := state.Name.ValueString()
 
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteIPPool(ctx, name)
-	})
+	err := r.client.DeleteIPPool(ctx, name)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting ip pool",
@@ -265,9 +273,10 @@ func (r *ipPoolResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *ipPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	name := req.ID
-
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	name := importIDOrIdentity(ctx, req, resp, "name")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	o, err := r.client.GetIPPool(ctx, name)
 	if err != nil {
@@ -288,6 +297,7 @@ func (r *ipPoolResource) ImportState(ctx context.Context, req resource.ImportSta
 		IPs:  types.ListValueMust(types.StringType, ipList),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -8,8 +8,12 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
 )
@@ -73,28 +77,48 @@ For more detailed information, please see the [SendGrid documentation](https://d
 		`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the branded link.",
-				Required:            true,
+				MarkdownDescription: "The ID of the branded link. Either `id`, or `domain` or `default` to look up the branded link, must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("domain"), path.MatchRoot("default")),
+				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "The root domain of the branded link.",
+				MarkdownDescription: "The root domain of the branded link. Set it instead of `id` to look up the branded link of this domain. SendGrid lists at most 500 branded links at once, so the lookup fails on accounts with more, rather than miss the branded link.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
 			},
 			"subdomain": schema.StringAttribute{
-				MarkdownDescription: "The subdomain used to generate the DNS records for this link branding. This subdomain must be different from the subdomain used for your authenticated domain.",
+				MarkdownDescription: "The subdomain used to generate the DNS records for this link branding. This subdomain must be different from the subdomain used for your authenticated domain. Set it with `domain` to narrow the lookup.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
 			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "The username of the account that this link branding is associated with.",
+				MarkdownDescription: "The username of the account that this link branding is associated with. Set it with `domain` to narrow the lookup.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
 			},
 			"user_id": schema.Int64Attribute{
 				MarkdownDescription: "The ID of the user that this link branding is associated with.",
 				Computed:            true,
 			},
 			"default": schema.BoolAttribute{
-				MarkdownDescription: "Indicates if this is the default link branding.",
+				MarkdownDescription: "Indicates if this is the default link branding. Set it to `true` instead of `id` to look up the default link branding.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
 			},
 			"legacy": schema.BoolAttribute{
 				MarkdownDescription: "Indicates if this link branding was created using the legacy whitelabel tool. If it is a legacy whitelabel, it will still function, but you'll need to create new link branding if you need to update it.",
@@ -141,6 +165,38 @@ func (d *linkBrandingDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	id := s.ID.ValueString()
+	if s.ID.IsNull() {
+		lookup := domainLookup{
+			Domain:    s.Domain,
+			Subdomain: s.Subdomain,
+			Username:  s.Username,
+			Default:   s.Default,
+		}
+		links, err := listAllBrandedLinks(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading link branding",
+				fmt.Sprintf("Unable to list branded links, got error: %s", err),
+			)
+			return
+		}
+
+		var ids []string
+		for _, v := range links {
+			if lookup.match(v.Domain, v.Subdomain, v.Username, v.Default) {
+				ids = append(ids, strconv.FormatInt(v.ID, 10))
+			}
+		}
+		id, err = singleID("branded link", lookup.String(), ids)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading link branding",
+				fmt.Sprintf("Unable to look up branded link, got error: %s", err),
+			)
+			return
+		}
+	}
+
 	linkId, _ := strconv.ParseInt(id, 10, 64)
 	o, err := d.client.GetBrandedLink(ctx, linkId)
 	if err != nil {
@@ -151,6 +207,7 @@ func (d *linkBrandingDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	s.ID = types.StringValue(id)
 	s.UserID = types.Int64Value(o.UserID)
 	s.Domain = types.StringValue(o.Domain)
 	s.Subdomain = types.StringValue(o.Subdomain)
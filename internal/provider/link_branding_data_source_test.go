@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -42,3 +43,103 @@ data "sendgrid_link_branding" "test" {
 }
 `, domain)
 }
+
+func TestAccLinkBrandingDataSource_domain(t *testing.T) {
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccLinkBrandingDataSourceConfigDomain(domain, `
+data "sendgrid_link_branding" "by_subdomain" {
+	domain    = sendgrid_link_branding.second.domain
+	subdomain = sendgrid_link_branding.second.subdomain
+}
+
+data "sendgrid_link_branding" "by_default" {
+	domain  = sendgrid_link_branding.first.domain
+	default = true
+
+	depends_on = [sendgrid_link_branding.second]
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sendgrid_link_branding.by_subdomain", "id", "sendgrid_link_branding.second", "id"),
+					resource.TestCheckResourceAttr("data.sendgrid_link_branding.by_subdomain", "subdomain", "link2"),
+					resource.TestCheckResourceAttr("data.sendgrid_link_branding.by_subdomain", "default", "false"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_link_branding.by_default", "id", "sendgrid_link_branding.first", "id"),
+					resource.TestCheckResourceAttr("data.sendgrid_link_branding.by_default", "subdomain", "link1"),
+				),
+			},
+			// Several branded links match the domain
+			{
+				Config: testAccLinkBrandingDataSourceConfigDomain(domain, `
+data "sendgrid_link_branding" "test" {
+	domain = sendgrid_link_branding.first.domain
+
+	depends_on = [sendgrid_link_branding.second]
+}
+`),
+				ExpectError: regexp.MustCompile(`2\s+branded\s+links\s+matching\s+domain\s+"` + domain + `"\s+were\s+found`),
+			},
+			// No branded link matches the subdomain
+			{
+				Config: testAccLinkBrandingDataSourceConfigDomain(domain, `
+data "sendgrid_link_branding" "test" {
+	domain    = sendgrid_link_branding.first.domain
+	subdomain = "link3"
+}
+`),
+				ExpectError: regexp.MustCompile(`no\s+branded\s+link\s+matching\s+domain\s+"` + domain + `"\s+and\s+subdomain\s+"link3"\s+was\s+found`),
+			},
+		},
+	})
+}
+
+func TestAccLinkBrandingDataSource_idAndDomain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "sendgrid_link_branding" "test" {
+	id     = "1"
+	domain = "example.com"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+data "sendgrid_link_branding" "test" {
+	subdomain = "link1"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccLinkBrandingDataSourceConfigDomain(domain, dataSources string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_link_branding" "first" {
+	domain    = "%[1]s"
+	subdomain = "link1"
+	default   = true
+}
+
+resource "sendgrid_link_branding" "second" {
+	domain    = "%[1]s"
+	subdomain = "link2"
+
+	depends_on = [sendgrid_link_branding.first]
+}
+`, domain) + dataSources
+}
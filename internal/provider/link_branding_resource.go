@@ -6,11 +6,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -25,6 +28,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &linkBrandingResource{}
 var _ resource.ResourceWithImportState = &linkBrandingResource{}
+var _ resource.ResourceWithIdentity = &linkBrandingResource{}
+var _ resource.ResourceWithModifyPlan = &linkBrandingResource{}
 
 func newLinkBrandingResource() resource.Resource {
 	return &linkBrandingResource{}
@@ -44,6 +49,20 @@ type linkBrandingResourceModel struct {
 	Legacy    types.Bool   `tfsdk:"legacy"`
 	Valid     types.Bool   `tfsdk:"valid"`
 	DNS       types.Set    `tfsdk:"dns"`
+
+	WaitForValidation      types.Bool   `tfsdk:"wait_for_validation"`
+	ValidationTimeout      types.String `tfsdk:"validation_timeout"`
+	ValidationPollInterval types.String `tfsdk:"validation_poll_interval"`
+}
+
+type linkBrandingIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (m linkBrandingResourceModel) identity() linkBrandingIdentityModel {
+	return linkBrandingIdentityModel{
+		ID: m.ID,
+	}
 }
 
 func (r *linkBrandingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -145,6 +164,18 @@ For more detailed information, please see the [SendGrid documentation](https://d
 			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, validationAttributes("the branded link", "sendgrid_link_branding_validation"))
+}
+
+func (r *linkBrandingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the branded link.",
+				RequiredForImport: true,
+			},
+		},
+	}
 }
 
 func (r *linkBrandingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -166,6 +197,10 @@ func (r *linkBrandingResource) Configure(ctx context.Context, req resource.Confi
 	r.client = client
 }
 
+func (r *linkBrandingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planRevalidation(ctx, req, resp, path.Root("dns"))
+}
+
 func (r *linkBrandingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data linkBrandingResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -187,9 +222,7 @@ func (r *linkBrandingResource) Create(ctx context.Context, req resource.CreateRe
 		input.Default = def
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateBrandedLink(context.TODO(), input)
-	})
+	o, err := r.client.CreateBrandedLink(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating branded link",
@@ -198,15 +231,6 @@ func (r *linkBrandingResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateBrandedLink)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating branded link",
-			"Failed to assert type *sendgrid.OutputCreateBrandedLink",
-		)
-		return
-	}
-
 	id := strconv.FormatInt(o.ID, 10)
 	data.ID = types.StringValue(id)
 	data.UserID = types.Int64Value(o.UserID)
@@ -218,7 +242,14 @@ func (r *linkBrandingResource) Create(ctx context.Context, req resource.CreateRe
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSBrandedLinkToSetType(o.DNS)
 
+	if data.WaitForValidation.ValueBool() && !o.Valid {
+		var validation diag.Diagnostics
+		r.waitForValidation(ctx, &data, &validation)
+		resp.Diagnostics.Append(createValidationDiagnostics(validation, "the branded link", "sendgrid_link_branding_validation")...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -252,6 +283,7 @@ func (r *linkBrandingResource) Read(ctx context.Context, req resource.ReadReques
 	data.DNS = convertDNSBrandedLinkToSetType(o.DNS)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -288,7 +320,12 @@ func (r *linkBrandingResource) Update(ctx context.Context, req resource.UpdateRe
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSBrandedLinkToSetType(o.DNS)
 
+	if data.WaitForValidation.ValueBool() && !o.Valid {
+		r.waitForValidation(ctx, &data, &resp.Diagnostics)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -303,9 +340,7 @@ func (r *linkBrandingResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	linkId := data.ID.ValueString()
 	id, _ := strconv.ParseInt(linkId, 10, 64)
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteBrandedLink(ctx, id)
-	})
+	err := r.client.DeleteBrandedLink(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting branded link",
@@ -318,9 +353,10 @@ func (r *linkBrandingResource) Delete(ctx context.Context, req resource.DeleteRe
 func (r *linkBrandingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data linkBrandingResourceModel
 
-	linkId := req.ID
-
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	linkId := importIDOrIdentity(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	id, err := strconv.ParseInt(linkId, 10, 64)
 	if err != nil {
@@ -349,13 +385,77 @@ func (r *linkBrandingResource) ImportState(ctx context.Context, req resource.Imp
 	data.Legacy = types.BoolValue(o.Legacy)
 	data.Valid = types.BoolValue(o.Valid)
 	data.DNS = convertDNSBrandedLinkToSetType(o.DNS)
+	data.WaitForValidation = types.BoolValue(false)
+	data.ValidationTimeout = types.StringValue(defaultValidationTimeout)
+	data.ValidationPollInterval = types.StringValue(defaultValidationPollInterval)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// waitForValidation validates the branded link until it is valid or the
+// configured timeout elapses, and refreshes valid and dns in data with the
+// outcome. Failing records are reported as an error, after which data is
+// still worth saving.
+func (r *linkBrandingResource) waitForValidation(ctx context.Context, data *linkBrandingResourceModel, diags *diag.Diagnostics) {
+	linkId, _ := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	timeout, interval := validationSettings(data.ValidationTimeout, data.ValidationPollInterval)
+
+	failures, err := waitForValidation(ctx, interval, timeout, func(ctx context.Context) (bool, []validationFailure, error) {
+		return validateBrandedLink(ctx, r.client, linkId)
+	})
+	if err != nil {
+		diags.AddError(
+			"Validating branded link",
+			fmt.Sprintf("Unable to validate branded link (id: %d), got error: %s", linkId, err),
+		)
+		return
+	}
+
+	o, err := r.client.GetBrandedLink(ctx, linkId)
+	if err != nil {
+		diags.AddError(
+			"Validating branded link",
+			fmt.Sprintf("Unable to get branded link (id: %d), got error: %s", linkId, err),
+		)
+		return
+	}
+	data.Valid = types.BoolValue(o.Valid)
+	data.DNS = convertDNSBrandedLinkToSetType(o.DNS)
+
+	if len(failures) > 0 {
+		diags.AddError(
+			"Validating branded link",
+			fmt.Sprintf("The DNS records of branded link %s.%s (id: %d) were not valid after %s:\n%s", data.Subdomain.ValueString(), data.Domain.ValueString(), linkId, timeout, formatValidationFailures(failures)),
+		)
+	}
+}
+
+// validateBrandedLink asks SendGrid to validate the DNS records of the
+// branded link once.
+func validateBrandedLink(ctx context.Context, client *sendgrid.Client, id int64) (bool, []validationFailure, error) {
+	o, err := client.ValidateBrandedLink(ctx, id)
+	if err != nil {
+		return false, nil, err
+	}
+	var failures []validationFailure
+	for _, result := range []struct {
+		record string
+		sendgrid.ValidationResult
+	}{
+		{"domain_cname", o.ValidationResults.DomainCname},
+		{"owner_cname", o.ValidationResults.OwnerCname},
+	} {
+		if !result.Valid && result.Reason != "" {
+			failures = append(failures, validationFailure{record: result.record, reason: result.Reason})
+		}
+	}
+	return o.Valid, failures, nil
+}
+
 func convertDNSBrandedLinkToSetType(dns sendgrid.DNSBrandedLink) (recordsSet basetypes.SetValue) {
 	var records []attr.Value
 
@@ -5,10 +5,14 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccLinkBrandingResource(t *testing.T) {
@@ -60,3 +64,118 @@ resource "sendgrid_link_branding" "test" {
 }
 `, domain, def)
 }
+
+func TestAccLinkBrandingResource_waitForValidation(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_link_branding.test"
+
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+	var hosts []string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create without waiting
+			{
+				Config: testAccLinkBrandingResourceConfigWaitForValidation(domain, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "false"),
+					testAccCheckLinkBrandingDNSHosts(resourceName, &hosts),
+				),
+			},
+			// Waiting times out while the DNS records are missing
+			{
+				Config:      testAccLinkBrandingResourceConfigWaitForValidation(domain, true),
+				ExpectError: regexp.MustCompile(`(?s)valid after 1s:.*domain_cname.*owner_cname`),
+			},
+			// Validation is retried once the DNS records are published
+			{
+				PreConfig: func() {
+					for _, host := range hosts {
+						server.PublishDNSRecord(host)
+					}
+				},
+				Config: testAccLinkBrandingResourceConfigWaitForValidation(domain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(resourceName, "dns.0.valid", "true"),
+					resource.TestCheckResourceAttr(resourceName, "dns.1.valid", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinkBrandingResource_waitForValidationOnCreate(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_link_branding.test"
+
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+	var hosts []string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Waiting times out on create, which only warns, so the branded
+			// link is saved as not valid and the next plan validates it again
+			{
+				Config: testAccLinkBrandingResourceConfigWaitForValidation(domain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "false"),
+					testAccCheckLinkBrandingDNSHosts(resourceName, &hosts),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// The branded link is not replaced, but validated again
+			{
+				PreConfig: func() {
+					for _, host := range hosts {
+						server.PublishDNSRecord(host)
+					}
+				},
+				Config: testAccLinkBrandingResourceConfigWaitForValidation(domain, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "true"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckLinkBrandingDNSHosts collects the hosts of the DNS records of
+// the branded link into hosts, for the test to publish them.
+func testAccCheckLinkBrandingDNSHosts(resourceName string, hosts *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*hosts = nil
+		attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+		for k, v := range attrs {
+			if strings.HasPrefix(k, "dns.") && strings.HasSuffix(k, ".host") {
+				*hosts = append(*hosts, v)
+			}
+		}
+		if len(*hosts) != 2 {
+			return fmt.Errorf("expected 2 DNS records, got %v", *hosts)
+		}
+		return nil
+	}
+}
+
+func testAccLinkBrandingResourceConfigWaitForValidation(domain string, wait bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_link_branding" "test" {
+  domain                   = "%s"
+  wait_for_validation      = %t
+  validation_timeout       = "1s"
+  validation_poll_interval = "100ms"
+}
+`, domain, wait)
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Page sizes used by the plural data sources. Each is the largest page the
+// endpoint accepts, or a conservative value where SendGrid does not document
+// one.
+const (
+	templateListPageSize            = 200
+	designListPageSize              = 100
+	teammateListPageSize            = 500
+	subuserListPageSize             = 100
+	authenticatedDomainListPageSize = 50
+	brandedLinkListLimit            = 500
+	accessRequestListPageSize       = 50
+)
+
+// regexFilterAttribute returns an optional attribute that filters the items of
+// a plural data source by matching one of their fields against a regular
+// expression.
+func regexFilterAttribute(what, field string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Only list the " + what + " whose " + field + " matches this regular expression.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringRegex(),
+		},
+	}
+}
+
+// regexFilter is the compiled value of a regexFilterAttribute. The zero value
+// matches everything.
+type regexFilter struct {
+	re *regexp.Regexp
+}
+
+// newRegexFilter compiles the value of a regexFilterAttribute. A null value
+// matches everything.
+func newRegexFilter(v types.String) (regexFilter, error) {
+	if v.IsNull() || v.IsUnknown() {
+		return regexFilter{}, nil
+	}
+	re, err := regexp.Compile(v.ValueString())
+	if err != nil {
+		return regexFilter{}, err
+	}
+	return regexFilter{re: re}, nil
+}
+
+func (f regexFilter) match(s string) bool {
+	return f.re == nil || f.re.MatchString(s)
+}
+
+// nextPageToken returns the page_token query parameter of the next page URL
+// in the _metadata of token-paginated endpoints, or "" on the last page.
+func nextPageToken(next string) string {
+	if next == "" {
+		return ""
+	}
+	u, err := url.Parse(next)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("page_token")
+}
+
+// listAllTemplates pages through the templates of the given comma-separated
+// generations.
+func listAllTemplates(ctx context.Context, client *sendgrid.Client, generations string) ([]sendgrid.Template, error) {
+	var items []sendgrid.Template
+	input := &sendgrid.InputGetTemplates{
+		Generations: generations,
+		PageSize:    templateListPageSize,
+	}
+	for {
+		o, err := client.GetTemplates(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, o.Templates...)
+
+		input.PageToken = nextPageToken(o.Metadata.Next)
+		if input.PageToken == "" || len(o.Templates) == 0 {
+			return items, nil
+		}
+	}
+}
+
+// listAllDesigns pages through the designs of the account.
+func listAllDesigns(ctx context.Context, client *sendgrid.Client) ([]*sendgrid.Design, error) {
+	var items []*sendgrid.Design
+	input := &sendgrid.InputGetDesigns{
+		PageSize: designListPageSize,
+		Summary:  true,
+	}
+	for {
+		o, err := client.GetDesigns(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, o.Result...)
+
+		input.PageToken = nextPageToken(o.Metadata.Next)
+		if input.PageToken == "" || len(o.Result) == 0 {
+			return items, nil
+		}
+	}
+}
+
+// listAllTeammates pages through the teammates of the account until SendGrid
+// returns a short page.
+func listAllTeammates(ctx context.Context, client *sendgrid.Client) ([]sendgrid.Teammate, error) {
+	var items []sendgrid.Teammate
+	input := &sendgrid.InputGetTeammates{
+		Limit: teammateListPageSize,
+	}
+	for {
+		o, err := client.GetTeammates(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, o.Teammates...)
+		if len(o.Teammates) < input.Limit {
+			return items, nil
+		}
+		input.Offset += len(o.Teammates)
+	}
+}
+
+// listAllSubusers pages through the subusers matching input until SendGrid
+// returns a short page.
+func listAllSubusers(ctx context.Context, client *sendgrid.Client, input sendgrid.InputGetSubusers) ([]*sendgrid.Subuser, error) {
+	var items []*sendgrid.Subuser
+	input.Limit = subuserListPageSize
+	for {
+		page, err := client.GetSubusers(ctx, &input)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if len(page) < input.Limit {
+			return items, nil
+		}
+		input.Offset += len(page)
+	}
+}
+
+// listAllAuthenticatedDomains pages through the authenticated domains
+// matching input until SendGrid returns a short page.
+func listAllAuthenticatedDomains(ctx context.Context, client *sendgrid.Client, input sendgrid.InputGetAuthenticatedDomains) ([]*sendgrid.DomainAuthentication, error) {
+	var items []*sendgrid.DomainAuthentication
+	input.Limit = authenticatedDomainListPageSize
+	for {
+		page, err := client.GetAuthenticatedDomains(ctx, &input)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if len(page) < input.Limit {
+			return items, nil
+		}
+		input.Offset += len(page)
+	}
+}
+
+// listAllBrandedLinks lists the branded links of the account. The endpoint
+// only accepts a limit, with no offset to page through, so the links are
+// fetched in one request with a generous limit. A full page may be missing
+// links, so it fails rather than return a partial list.
+func listAllBrandedLinks(ctx context.Context, client *sendgrid.Client) ([]*sendgrid.BrandedLink, error) {
+	links, err := client.GetBrandedLinks(ctx, &sendgrid.InputGetBrandedLinks{
+		Limit: brandedLinkListLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(links) >= brandedLinkListLimit {
+		return nil, fmt.Errorf("SendGrid returned %d branded links, the most it lists at once, so the list may be truncated; look up the branded link by id instead", len(links))
+	}
+	return links, nil
+}
+
+// listAllAPIKeys lists the API keys of the account. The endpoint only accepts
+// a limit, with no offset to page through, and lists every API key when no
+// limit is given, so they are fetched in one request.
+//
+// see: https://www.twilio.com/docs/sendgrid/api-reference/api-keys/retrieve-all-api-keys-belonging-to-the-authenticated-user
+func listAllAPIKeys(ctx context.Context, client *sendgrid.Client) ([]sendgrid.APIKey, error) {
+	o, err := client.GetAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.APIKeys, nil
+}
+
+// singleIDByName returns the only ID in ids, the IDs of the objects named
+// name, or an error explaining why name does not identify a single object.
+func singleIDByName(what, name string, ids []string) (string, error) {
+	return singleID(what, fmt.Sprintf("named %q", name), ids)
+}
+
+// singleID returns the only ID in ids, the IDs of the objects matching the
+// lookup described by criteria, or an error explaining why the lookup does
+// not identify a single object.
+func singleID(what, criteria string, ids []string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s %s was found", what, criteria)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d %ss %s were found (ids: %s), set id to select one", len(ids), what, criteria, strings.Join(ids, ", "))
+	}
+}
+
+// domainLookup is the lookup of an authenticated domain or a branded link by
+// its domain rather than its ID. Null fields match any value.
+type domainLookup struct {
+	Domain    types.String
+	Subdomain types.String
+	Username  types.String
+	Default   types.Bool
+}
+
+func (l domainLookup) match(domain, subdomain, username string, isDefault bool) bool {
+	return (l.Domain.IsNull() || l.Domain.ValueString() == domain) &&
+		(l.Subdomain.IsNull() || l.Subdomain.ValueString() == subdomain) &&
+		(l.Username.IsNull() || l.Username.ValueString() == username) &&
+		(l.Default.IsNull() || l.Default.ValueBool() == isDefault)
+}
+
+// String describes the lookup for error messages, for example
+// `matching domain "example.com" and default true`.
+func (l domainLookup) String() string {
+	var criteria []string
+	if !l.Domain.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("domain %q", l.Domain.ValueString()))
+	}
+	if !l.Subdomain.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("subdomain %q", l.Subdomain.ValueString()))
+	}
+	if !l.Username.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("username %q", l.Username.ValueString()))
+	}
+	if !l.Default.IsNull() {
+		criteria = append(criteria, fmt.Sprintf("default %t", l.Default.ValueBool()))
+	}
+	if len(criteria) == 0 {
+		return "matching any domain"
+	}
+	if len(criteria) == 1 {
+		return "matching " + criteria[0]
+	}
+	return "matching " + strings.Join(criteria[:len(criteria)-1], ", ") + " and " + criteria[len(criteria)-1]
+}
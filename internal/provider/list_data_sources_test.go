@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/kenzo0107/sendgrid"
+)
+
+func TestNextPageToken(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		next string
+		want string
+	}{
+		{name: "last page", next: "", want: ""},
+		{name: "next page", next: "https://api.sendgrid.com/v3/templates?page_size=200&page_token=abc", want: "abc"},
+		{name: "no token", next: "https://api.sendgrid.com/v3/templates?page_size=200", want: ""},
+		{name: "invalid url", next: "%zz", want: ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := nextPageToken(c.next); got != c.want {
+				t.Errorf("nextPageToken(%q) = %q, want %q", c.next, got, c.want)
+			}
+		})
+	}
+}
+
+func TestListAllTemplatesPages(t *testing.T) {
+	server := testAccOffline(t)
+
+	client := sendgrid.New("SG.offline", sendgrid.OptionBaseURL(server.BaseURL()))
+	ctx := t.Context()
+
+	before, err := listAllTemplates(ctx, client, "legacy,dynamic")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := templateListPageSize + 1
+	for i := range n {
+		o, err := client.CreateTemplate(ctx, &sendgrid.InputCreateTemplate{
+			Name:       fmt.Sprintf("test-pages-%d", i),
+			Generation: "dynamic",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			_ = client.DeleteTemplate(context.Background(), o.ID)
+		})
+	}
+
+	after, err := listAllTemplates(ctx, client, "legacy,dynamic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(after), len(before)+n; got != want {
+		t.Errorf("listAllTemplates returned %d templates, want %d", got, want)
+	}
+}
+
+func TestListAllAPIKeysUnpaged(t *testing.T) {
+	server := testAccOffline(t)
+
+	client := sendgrid.New("SG.offline", sendgrid.OptionBaseURL(server.BaseURL()))
+	ctx := t.Context()
+
+	before, err := listAllAPIKeys(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := 101
+	for i := range n {
+		o, err := client.CreateAPIKey(ctx, &sendgrid.InputCreateAPIKey{
+			Name: fmt.Sprintf("test-unpaged-%d", i),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			_ = client.DeleteAPIKey(context.Background(), o.ApiKeyId)
+		})
+	}
+
+	requests := len(server.Requests())
+	after, err := listAllAPIKeys(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(after), len(before)+n; got != want {
+		t.Errorf("listAllAPIKeys returned %d api keys, want %d", got, want)
+	}
+	if got := len(server.Requests()) - requests; got != 1 {
+		t.Errorf("listAllAPIKeys made %d requests, want 1", got)
+	}
+}
+
+func TestListAllBrandedLinksTruncated(t *testing.T) {
+	server := testAccOffline(t)
+
+	client := sendgrid.New("SG.offline", sendgrid.OptionBaseURL(server.BaseURL()))
+	ctx := t.Context()
+
+	for i := range brandedLinkListLimit + 1 {
+		o, err := client.CreateBrandedLink(ctx, &sendgrid.InputCreateBrandedLink{
+			Domain:    fmt.Sprintf("test-truncated-%d.example.com", i),
+			Subdomain: "link",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			_ = client.DeleteBrandedLink(context.Background(), o.ID)
+		})
+	}
+
+	if _, err := listAllBrandedLinks(ctx, client); err == nil {
+		t.Error("expected listAllBrandedLinks to fail when the list may be truncated")
+	}
+
+	// A lookup by domain cannot tell a missing link from a truncated list.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "sendgrid_link_branding" "test" {
+	domain = "test-truncated-0.example.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`may be truncated`),
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// List resources back the `list` blocks of Terraform queries. Each one embeds
+// the resource it lists, so that both share Metadata and Configure, and
+// yields results shaped as if each object had just been imported.
+
+// listRegexFilterAttribute is the list resource counterpart of
+// regexFilterAttribute.
+func listRegexFilterAttribute(what, field string) listschema.StringAttribute {
+	return listschema.StringAttribute{
+		MarkdownDescription: "Only list the " + what + " whose " + field + " matches this regular expression.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringRegex(),
+		},
+	}
+}
+
+// listOnBehalfOfAttribute is the list resource counterpart of
+// onBehalfOfAttribute.
+func listOnBehalfOfAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		MarkdownDescription: "The username of the subuser to list on behalf of, instead of the provider's `subuser`. The listed resources are managed on behalf of it.",
+		Optional:            true,
+	}
+}
+
+// listErrorResult returns a result made of a single error, which ends the
+// query.
+func listErrorResult(summary, detail string) list.ListResult {
+	var diags diag.Diagnostics
+	diags.AddError(summary, detail)
+	return list.ListResult{Diagnostics: diags}
+}
+
+// listErrorResults returns a stream made of a single listErrorResult.
+func listErrorResults(summary, detail string) iter.Seq[list.ListResult] {
+	return list.ListResultsStreamDiagnostics(listErrorResult(summary, detail).Diagnostics)
+}
+
+// newListResult returns the result of an object with the given identity.
+// data, a pointer to the resource model, is only read when the query
+// includes resources.
+func newListResult(ctx context.Context, req list.ListRequest, displayName string, identity, data any) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+	result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+	if req.IncludeResource {
+		result.Diagnostics.Append(result.Resource.Set(ctx, data)...)
+	}
+	return result
+}
+
+// limitResults wraps push so that it stops the stream once the number of
+// results asked for by the query has been pushed.
+func limitResults(req list.ListRequest, push func(list.ListResult) bool) func(list.ListResult) bool {
+	var n int64
+	return func(result list.ListResult) bool {
+		if !push(result) {
+			return false
+		}
+		n++
+		return req.Limit <= 0 || n < req.Limit
+	}
+}
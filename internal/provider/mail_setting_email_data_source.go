@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &mailSettingEmailDataSource{}
+	_ datasource.DataSourceWithConfigure = &mailSettingEmailDataSource{}
+)
+
+func newBCCSettingsDataSource() datasource.DataSource {
+	return &mailSettingEmailDataSource{descriptor: bccSetting}
+}
+
+func newForwardBounceSettingsDataSource() datasource.DataSource {
+	return &mailSettingEmailDataSource{descriptor: forwardBounceSetting}
+}
+
+func newForwardSpamSettingsDataSource() datasource.DataSource {
+	return &mailSettingEmailDataSource{descriptor: forwardSpamSetting}
+}
+
+// mailSettingEmailDataSource reads a mail setting that sends emails to an
+// address when it is turned on.
+type mailSettingEmailDataSource struct {
+	client     *sendgrid.Client
+	descriptor mailSettingDescriptor
+}
+
+type mailSettingEmailDataSourceModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Email   types.String `tfsdk:"email"`
+}
+
+func (d *mailSettingEmailDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.descriptor.typeName
+}
+
+func (d *mailSettingEmailDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *mailSettingEmailDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(`
+Retrieve the %s mail setting of your SendGrid account.
+%s
+		`, d.descriptor.title, d.descriptor.description),
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Indicates if the %s mail setting is enabled.", d.descriptor.title),
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address emails are sent to when the setting is enabled.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *mailSettingEmailDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state mailSettingEmailDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getMailSetting[mailSettingEmail](ctx, d.client, d.descriptor.setting)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Reading %s settings", d.descriptor.title),
+			fmt.Sprintf("Unable to get %s settings, got error: %s", d.descriptor.title, err),
+		)
+		return
+	}
+
+	u := mailSettingEmailDataSourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		Email:   types.StringValue(o.Email),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &u)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
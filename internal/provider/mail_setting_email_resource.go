@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &mailSettingEmailResource{}
+var _ resource.ResourceWithImportState = &mailSettingEmailResource{}
+
+var (
+	bccSetting = mailSettingDescriptor{
+		setting:  "bcc",
+		typeName: "_bcc_settings",
+		title:    "BCC",
+		description: `
+The BCC mail setting sends a blind carbon copy of every email you send to the address you specify. SendGrid recommends using the ` + "`bcc`" + ` parameter of the Mail Send API instead.`,
+	}
+	forwardBounceSetting = mailSettingDescriptor{
+		setting:  "forward_bounce",
+		typeName: "_forward_bounce_settings",
+		title:    "forward bounce",
+		description: `
+The Forward Bounce mail setting forwards the bounce reports of your emails to the address you specify.`,
+	}
+	forwardSpamSetting = mailSettingDescriptor{
+		setting:  "forward_spam",
+		typeName: "_forward_spam_settings",
+		title:    "forward spam",
+		description: `
+The Forward Spam mail setting forwards the spam reports of your emails to the addresses you specify. Separate multiple addresses with commas.`,
+	}
+)
+
+func newBCCSettingsResource() resource.Resource {
+	return &mailSettingEmailResource{descriptor: bccSetting}
+}
+
+func newForwardBounceSettingsResource() resource.Resource {
+	return &mailSettingEmailResource{descriptor: forwardBounceSetting}
+}
+
+func newForwardSpamSettingsResource() resource.Resource {
+	return &mailSettingEmailResource{descriptor: forwardSpamSetting}
+}
+
+// mailSettingEmailResource manages a mail setting that sends emails to an
+// address when it is turned on.
+type mailSettingEmailResource struct {
+	client     *sendgrid.Client
+	descriptor mailSettingDescriptor
+}
+
+type mailSettingEmailResourceModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Email   types.String `tfsdk:"email"`
+}
+
+func (r *mailSettingEmailResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.descriptor.typeName
+}
+
+func (r *mailSettingEmailResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(`
+Manage the %s mail setting of your SendGrid account.
+%s
+
+Destroying this resource disables the setting and clears its email address.
+		`, r.descriptor.title, r.descriptor.description),
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Indicates if the %s mail setting is enabled.", r.descriptor.title),
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address to send to when the setting is enabled.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (r *mailSettingEmailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *mailSettingEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mailSettingEmailResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := updateMailSetting(ctx, r.client, r.descriptor.setting, &mailSettingEmail{
+		Enabled: plan.Enabled.ValueBool(),
+		Email:   plan.Email.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Creating %s settings", r.descriptor.title),
+			fmt.Sprintf("Unable to update %s settings, got error: %s", r.descriptor.title, err),
+		)
+		return
+	}
+
+	plan = mailSettingEmailResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		Email:   types.StringValue(o.Email),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *mailSettingEmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mailSettingEmailResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getMailSetting[mailSettingEmail](ctx, r.client, r.descriptor.setting)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Reading %s settings", r.descriptor.title),
+			fmt.Sprintf("Unable to read %s settings, got error: %s", r.descriptor.title, err),
+		)
+		return
+	}
+
+	state = mailSettingEmailResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		Email:   types.StringValue(o.Email),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *mailSettingEmailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data mailSettingEmailResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := updateMailSetting(ctx, r.client, r.descriptor.setting, &mailSettingEmail{
+		Enabled: data.Enabled.ValueBool(),
+		Email:   data.Email.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Updating %s settings", r.descriptor.title),
+			fmt.Sprintf("Unable to update %s settings, got error: %s", r.descriptor.title, err),
+		)
+		return
+	}
+
+	data = mailSettingEmailResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		Email:   types.StringValue(o.Email),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *mailSettingEmailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state mailSettingEmailResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mail settings cannot be deleted, so reset the setting to its default.
+	_, err := updateMailSetting(ctx, r.client, r.descriptor.setting, &mailSettingEmail{
+		Enabled: false,
+		Email:   "",
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Deleting %s settings", r.descriptor.title),
+			fmt.Sprintf("Unable to reset %s settings, got error: %s", r.descriptor.title, err),
+		)
+		return
+	}
+}
+
+func (r *mailSettingEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data mailSettingEmailResourceModel
+
+	o, err := getMailSetting[mailSettingEmail](ctx, r.client, r.descriptor.setting)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Importing %s settings", r.descriptor.title),
+			fmt.Sprintf("Unable to read %s settings, got error: %s", r.descriptor.title, err),
+		)
+		return
+	}
+
+	data = mailSettingEmailResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+		Email:   types.StringValue(o.Email),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
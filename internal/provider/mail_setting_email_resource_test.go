@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBCCSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_bcc_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMailSettingEmailResourceConfig("bcc", true, "bcc@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "email", "bcc@example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+			// Update and Read testing
+			{
+				Config: testAccMailSettingEmailResourceConfig("bcc", false, "bcc-updated@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "email", "bcc-updated@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccForwardBounceSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_forward_bounce_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMailSettingEmailResourceConfig("forward_bounce", true, "forward-bounce@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "email", "forward-bounce@example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+			// Update and Read testing
+			{
+				Config: testAccMailSettingEmailResourceConfig("forward_bounce", false, "forward-bounce-updated@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "email", "forward-bounce-updated@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccForwardSpamSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_forward_spam_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMailSettingEmailResourceConfig("forward_spam", true, "forward-spam@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "email", "forward-spam@example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+			// Update and Read testing
+			{
+				Config: testAccMailSettingEmailResourceConfig("forward_spam", false, "forward-spam-updated@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "email", "forward-spam-updated@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMailSettingEmailResourceConfig(setting string, enabled bool, email string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_%s_settings" "test" {
+  enabled = %t
+  email   = %q
+}`, setting, enabled, email)
+}
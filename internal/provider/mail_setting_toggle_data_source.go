@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &mailSettingToggleDataSource{}
+	_ datasource.DataSourceWithConfigure = &mailSettingToggleDataSource{}
+)
+
+func newBypassListManagementSettingsDataSource() datasource.DataSource {
+	return &mailSettingToggleDataSource{descriptor: bypassListManagementSetting}
+}
+
+func newBypassSpamManagementSettingsDataSource() datasource.DataSource {
+	return &mailSettingToggleDataSource{descriptor: bypassSpamManagementSetting}
+}
+
+func newBypassBounceManagementSettingsDataSource() datasource.DataSource {
+	return &mailSettingToggleDataSource{descriptor: bypassBounceManagementSetting}
+}
+
+func newBypassUnsubscribeManagementSettingsDataSource() datasource.DataSource {
+	return &mailSettingToggleDataSource{descriptor: bypassUnsubscribeManagementSetting}
+}
+
+func newPlainContentSettingsDataSource() datasource.DataSource {
+	return &mailSettingToggleDataSource{descriptor: plainContentSetting}
+}
+
+// mailSettingToggleDataSource reads a mail setting that can only be turned on
+// and off.
+type mailSettingToggleDataSource struct {
+	client     *sendgrid.Client
+	descriptor mailSettingDescriptor
+}
+
+type mailSettingToggleDataSourceModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func (d *mailSettingToggleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.descriptor.typeName
+}
+
+func (d *mailSettingToggleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *mailSettingToggleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(`
+Retrieve the %s mail setting of your SendGrid account.
+%s
+		`, d.descriptor.title, d.descriptor.description),
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Indicates if the %s mail setting is enabled.", d.descriptor.title),
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *mailSettingToggleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state mailSettingToggleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getMailSetting[mailSettingToggle](ctx, d.client, d.descriptor.setting)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Reading %s settings", d.descriptor.title),
+			fmt.Sprintf("Unable to get %s settings, got error: %s", d.descriptor.title, err),
+		)
+		return
+	}
+
+	u := mailSettingToggleDataSourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &u)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
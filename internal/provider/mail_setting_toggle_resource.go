@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &mailSettingToggleResource{}
+var _ resource.ResourceWithImportState = &mailSettingToggleResource{}
+
+var (
+	bypassListManagementSetting = mailSettingDescriptor{
+		setting:  "bypass_list_management",
+		typeName: "_bypass_list_management_settings",
+		title:    "bypass list management",
+		description: `
+Bypass List Management allows you to bypass all unsubscribe groups and suppressions to ensure that the email is delivered to every single recipient. This should only be used in emergencies when it is absolutely necessary that every recipient receives your email.`,
+	}
+	bypassSpamManagementSetting = mailSettingDescriptor{
+		setting:  "bypass_spam_management",
+		typeName: "_bypass_spam_management_settings",
+		title:    "bypass spam management",
+		description: `
+Bypass Spam Management allows you to bypass the spam report list to ensure that the email is delivered to recipients who have reported your emails as spam. Bounce and unsubscribe lists will still be checked.`,
+	}
+	bypassBounceManagementSetting = mailSettingDescriptor{
+		setting:  "bypass_bounce_management",
+		typeName: "_bypass_bounce_management_settings",
+		title:    "bypass bounce management",
+		description: `
+Bypass Bounce Management allows you to bypass the bounce list to ensure that the email is delivered to recipients whose addresses have bounced. Spam report and unsubscribe lists will still be checked.`,
+	}
+	bypassUnsubscribeManagementSetting = mailSettingDescriptor{
+		setting:  "bypass_unsubscribe_management",
+		typeName: "_bypass_unsubscribe_management_settings",
+		title:    "bypass unsubscribe management",
+		description: `
+Bypass Unsubscribe Management allows you to bypass the global unsubscribe list to ensure that the email is delivered to recipients who have unsubscribed from all your emails. Unsubscribe groups, bounce and spam report lists will still be checked.`,
+	}
+	plainContentSetting = mailSettingDescriptor{
+		setting:  "plain_content",
+		typeName: "_plain_content_settings",
+		title:    "plain content",
+		description: `
+The Plain Content setting sends only the plain text version of your emails, dropping their HTML content.`,
+	}
+)
+
+func newBypassListManagementSettingsResource() resource.Resource {
+	return &mailSettingToggleResource{descriptor: bypassListManagementSetting}
+}
+
+func newBypassSpamManagementSettingsResource() resource.Resource {
+	return &mailSettingToggleResource{descriptor: bypassSpamManagementSetting}
+}
+
+func newBypassBounceManagementSettingsResource() resource.Resource {
+	return &mailSettingToggleResource{descriptor: bypassBounceManagementSetting}
+}
+
+func newBypassUnsubscribeManagementSettingsResource() resource.Resource {
+	return &mailSettingToggleResource{descriptor: bypassUnsubscribeManagementSetting}
+}
+
+func newPlainContentSettingsResource() resource.Resource {
+	return &mailSettingToggleResource{descriptor: plainContentSetting}
+}
+
+// mailSettingToggleResource manages a mail setting that can only be turned on
+// and off.
+type mailSettingToggleResource struct {
+	client     *sendgrid.Client
+	descriptor mailSettingDescriptor
+}
+
+type mailSettingToggleResourceModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func (r *mailSettingToggleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.descriptor.typeName
+}
+
+func (r *mailSettingToggleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(`
+Manage the %s mail setting of your SendGrid account.
+%s
+
+Destroying this resource disables the setting.
+		`, r.descriptor.title, r.descriptor.description),
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Indicates if the %s mail setting is enabled.", r.descriptor.title),
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *mailSettingToggleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *mailSettingToggleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mailSettingToggleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := updateMailSetting(ctx, r.client, r.descriptor.setting, &mailSettingToggle{
+		Enabled: plan.Enabled.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Creating %s settings", r.descriptor.title),
+			fmt.Sprintf("Unable to update %s settings, got error: %s", r.descriptor.title, err),
+		)
+		return
+	}
+
+	plan = mailSettingToggleResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *mailSettingToggleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mailSettingToggleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getMailSetting[mailSettingToggle](ctx, r.client, r.descriptor.setting)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Reading %s settings", r.descriptor.title),
+			fmt.Sprintf("Unable to read %s settings, got error: %s", r.descriptor.title, err),
+		)
+		return
+	}
+
+	state = mailSettingToggleResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *mailSettingToggleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data mailSettingToggleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := updateMailSetting(ctx, r.client, r.descriptor.setting, &mailSettingToggle{
+		Enabled: data.Enabled.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Updating %s settings", r.descriptor.title),
+			fmt.Sprintf("Unable to update %s settings, got error: %s", r.descriptor.title, err),
+		)
+		return
+	}
+
+	data = mailSettingToggleResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *mailSettingToggleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state mailSettingToggleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mail settings cannot be deleted, so reset the setting to its default.
+	_, err := updateMailSetting(ctx, r.client, r.descriptor.setting, &mailSettingToggle{
+		Enabled: false,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Deleting %s settings", r.descriptor.title),
+			fmt.Sprintf("Unable to reset %s settings, got error: %s", r.descriptor.title, err),
+		)
+		return
+	}
+}
+
+func (r *mailSettingToggleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data mailSettingToggleResourceModel
+
+	o, err := getMailSetting[mailSettingToggle](ctx, r.client, r.descriptor.setting)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Importing %s settings", r.descriptor.title),
+			fmt.Sprintf("Unable to read %s settings, got error: %s", r.descriptor.title, err),
+		)
+		return
+	}
+
+	data = mailSettingToggleResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
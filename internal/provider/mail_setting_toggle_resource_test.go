@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBypassListManagementSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_bypass_list_management_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMailSettingToggleResourceConfig("bypass_list_management", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "enabled",
+			},
+			// Update and Read testing
+			{
+				Config: testAccMailSettingToggleResourceConfig("bypass_list_management", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBypassSpamManagementSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_bypass_spam_management_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMailSettingToggleResourceConfig("bypass_spam_management", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "enabled",
+			},
+			// Update and Read testing
+			{
+				Config: testAccMailSettingToggleResourceConfig("bypass_spam_management", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBypassBounceManagementSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_bypass_bounce_management_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMailSettingToggleResourceConfig("bypass_bounce_management", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "enabled",
+			},
+			// Update and Read testing
+			{
+				Config: testAccMailSettingToggleResourceConfig("bypass_bounce_management", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBypassUnsubscribeManagementSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_bypass_unsubscribe_management_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMailSettingToggleResourceConfig("bypass_unsubscribe_management", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "enabled",
+			},
+			// Update and Read testing
+			{
+				Config: testAccMailSettingToggleResourceConfig("bypass_unsubscribe_management", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPlainContentSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_plain_content_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMailSettingToggleResourceConfig("plain_content", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "enabled",
+			},
+			// Update and Read testing
+			{
+				Config: testAccMailSettingToggleResourceConfig("plain_content", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMailSettingToggleResourceConfig(setting string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_%s_settings" "test" {
+  enabled = %t
+}`, setting, enabled)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+// mailSettingDescriptor describes one of the mail settings whose resources
+// and data sources share an implementation.
+type mailSettingDescriptor struct {
+	// setting is the name of the setting in /v3/mail_settings/{setting}.
+	setting string
+	// typeName is the name of the resource and data source without the
+	// provider prefix.
+	typeName string
+	// title is the human readable name of the setting.
+	title string
+	// description is the Markdown description of the setting, shared by the
+	// resource and data source.
+	description string
+}
+
+// The sendgrid client only covers the bounce purge mail setting, so the other
+// mail settings are called through its generic request helpers. Each type
+// below is both the request and the response body of
+// /v3/mail_settings/{setting}; enabled is never omitted, so that settings can
+// be turned off.
+
+type mailSettingToggle struct {
+	Enabled bool `json:"enabled"`
+}
+
+type mailSettingEmail struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email"`
+}
+
+type mailSettingAddressWhitelist struct {
+	Enabled bool     `json:"enabled"`
+	List    []string `json:"list"`
+}
+
+type mailSettingFooter struct {
+	Enabled      bool   `json:"enabled"`
+	HTMLContent  string `json:"html_content"`
+	PlainContent string `json:"plain_content"`
+}
+
+type mailSettingTemplate struct {
+	Enabled     bool   `json:"enabled"`
+	HTMLContent string `json:"html_content"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail
+func getMailSetting[T any](ctx context.Context, client *sendgrid.Client, setting string) (*T, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("/mail_settings/%s", setting), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	o := new(T)
+	if err := client.Do(ctx, req, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func updateMailSetting[T any](ctx context.Context, client *sendgrid.Client, setting string, input *T) (*T, error) {
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("/mail_settings/%s", setting), input)
+	if err != nil {
+		return nil, err
+	}
+
+	o := new(T)
+	if err := client.Do(ctx, req, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+type onBehalfOfKey struct{}
+
+// withOnBehalfOf returns a copy of ctx in which API requests are made on
+// behalf of the given subuser, overriding the provider's subuser. An empty
+// subuser leaves ctx unchanged.
+func withOnBehalfOf(ctx context.Context, subuser string) context.Context {
+	if subuser == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, onBehalfOfKey{}, subuser)
+}
+
+// onBehalfOfHTTPClient sets the On-Behalf-Of header of requests whose context
+// carries a subuser from withOnBehalfOf. The SendGrid client only supports
+// one subuser per client, so this is what lets a single provider manage
+// resources of many subusers.
+type onBehalfOfHTTPClient struct {
+	next httpDoer
+}
+
+func (c *onBehalfOfHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if subuser, ok := req.Context().Value(onBehalfOfKey{}).(string); ok {
+		req.Header.Set("On-Behalf-Of", subuser)
+	}
+	return c.next.Do(req)
+}
+
+// onBehalfOfAttribute is the schema of the on_behalf_of attribute shared by
+// resources that can be managed on behalf of a subuser.
+func onBehalfOfAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The username of the subuser to manage this resource on behalf of, instead of the provider's `subuser`. Changing this forces a new resource to be created. When importing, prefix the ID with the subuser and a slash, e.g. `subuser/id`.",
+		Optional:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// onBehalfOfDataSourceAttribute is the schema of the on_behalf_of attribute
+// shared by data sources that can read objects of a subuser.
+func onBehalfOfDataSourceAttribute() dsschema.StringAttribute {
+	return dsschema.StringAttribute{
+		MarkdownDescription: "The username of the subuser to read on behalf of, instead of the provider's `subuser`.",
+		Optional:            true,
+	}
+}
+
+// splitOnBehalfOfImportID splits an import ID of the form subuser/id. IDs
+// without a subuser are returned unchanged with an empty subuser.
+func splitOnBehalfOfImportID(importID string) (subuser, id string) {
+	if subuser, id, ok := strings.Cut(importID, "/"); ok {
+		return subuser, id
+	}
+	return "", importID
+}
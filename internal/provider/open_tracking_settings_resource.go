@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &openTrackingSettingsResource{}
+var _ resource.ResourceWithImportState = &openTrackingSettingsResource{}
+
+func newOpenTrackingSettingsResource() resource.Resource {
+	return &openTrackingSettingsResource{}
+}
+
+type openTrackingSettingsResource struct {
+	client *sendgrid.Client
+}
+
+type openTrackingSettingsResourceModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func (r *openTrackingSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_open_tracking_settings"
+}
+
+func (r *openTrackingSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Open Tracking adds an invisible image at the end of your emails so that SendGrid can track when they are [opened](https://www.twilio.com/docs/sendgrid/glossary/opens).
+
+The ` + "`open`" + ` event of ` + "`sendgrid_event_webhook`" + ` is only sent when open tracking is enabled.
+
+Destroying this resource disables the setting.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if open tracking is enabled.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *openTrackingSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *openTrackingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan openTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.UpdateOpenTrackingSettings(ctx, &sendgrid.InputUpdateOpenTrackingSettings{
+		Enabled: plan.Enabled.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating open tracking settings",
+			fmt.Sprintf("Unable to update open tracking settings, got error: %s", err),
+		)
+		return
+	}
+
+	plan = openTrackingSettingsResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *openTrackingSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state openTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.GetOpenTrackingSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading open tracking settings",
+			fmt.Sprintf("Unable to read open tracking settings, got error: %s", err),
+		)
+		return
+	}
+
+	state = openTrackingSettingsResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *openTrackingSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data openTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.UpdateOpenTrackingSettings(ctx, &sendgrid.InputUpdateOpenTrackingSettings{
+		Enabled: data.Enabled.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating open tracking settings",
+			fmt.Sprintf("Unable to update open tracking settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = openTrackingSettingsResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *openTrackingSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state openTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tracking settings cannot be deleted, so disable the setting instead.
+	_, err := r.client.UpdateOpenTrackingSettings(ctx, &sendgrid.InputUpdateOpenTrackingSettings{
+		Enabled: false,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting open tracking settings",
+			fmt.Sprintf("Unable to disable open tracking settings, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *openTrackingSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data openTrackingSettingsResourceModel
+
+	o, err := r.client.GetOpenTrackingSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing open tracking settings",
+			fmt.Sprintf("Unable to read open tracking settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = openTrackingSettingsResourceModel{
+		Enabled: types.BoolValue(o.Enabled),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pendingTeammatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &pendingTeammatesDataSource{}
+)
+
+func newPendingTeammatesDataSource() datasource.DataSource {
+	return &pendingTeammatesDataSource{}
+}
+
+type pendingTeammatesDataSource struct {
+	client *sendgrid.Client
+}
+
+type pendingTeammatesDataSourceModel struct {
+	EmailRegex       types.String            `tfsdk:"email_regex"`
+	Expired          types.Bool              `tfsdk:"expired"`
+	PendingTeammates []pendingTeammatesModel `tfsdk:"pending_teammates"`
+}
+
+type pendingTeammatesModel struct {
+	Email               types.String   `tfsdk:"email"`
+	IsAdmin             types.Bool     `tfsdk:"is_admin"`
+	Scopes              []types.String `tfsdk:"scopes"`
+	InvitationExpiresAt types.String   `tfsdk:"invitation_expires_at"`
+	Expired             types.Bool     `tfsdk:"expired"`
+}
+
+func (d *pendingTeammatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pending_teammates"
+}
+
+func (d *pendingTeammatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *pendingTeammatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the pending teammates of your SendGrid account, who have been invited but have not accepted their invitation yet.
+
+Invitations expire seven days after they are sent. An expired invitation can be resent with the ` + "`resend_invitation_trigger`" + ` attribute of the ` + "`sendgrid_teammate`" + ` resource.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"email_regex": regexFilterAttribute("pending teammates", "email address"),
+			"expired": schema.BoolAttribute{
+				MarkdownDescription: "Only list the pending teammates whose invitation has expired, when `true`, or has not, when `false`.",
+				Optional:            true,
+			},
+			"pending_teammates": schema.ListNestedAttribute{
+				MarkdownDescription: "The pending teammates matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address the invitation was sent to.",
+							Computed:            true,
+						},
+						"is_admin": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if the teammate is invited with admin permissions.",
+							Computed:            true,
+						},
+						"scopes": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "The scopes the teammate is invited with.",
+							Computed:            true,
+						},
+						"invitation_expires_at": schema.StringAttribute{
+							MarkdownDescription: "When the invitation expires, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"expired": schema.BoolAttribute{
+							MarkdownDescription: "Whether the invitation has expired.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *pendingTeammatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data pendingTeammatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emailRegex, err := newRegexFilter(data.EmailRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading pending teammates",
+			fmt.Sprintf("Unable to compile email_regex, got error: %s", err),
+		)
+		return
+	}
+
+	o, err := d.client.GetPendingTeammates(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading pending teammates",
+			fmt.Sprintf("Unable to get pending teammates, got error: %s", err),
+		)
+		return
+	}
+
+	now := time.Now().Unix()
+	data.PendingTeammates = []pendingTeammatesModel{}
+	for _, v := range o.PendingTeammates {
+		expired := v.ExpirationDate != 0 && int64(v.ExpirationDate) < now
+		if !emailRegex.match(v.Email) {
+			continue
+		}
+		if !data.Expired.IsNull() && expired != data.Expired.ValueBool() {
+			continue
+		}
+
+		scopes := []types.String{}
+		for _, s := range v.Scopes {
+			if slices.Contains(autoScopes, s) {
+				continue
+			}
+			scopes = append(scopes, types.StringValue(s))
+		}
+
+		data.PendingTeammates = append(data.PendingTeammates, pendingTeammatesModel{
+			Email:               types.StringValue(v.Email),
+			IsAdmin:             types.BoolValue(v.IsAdmin),
+			Scopes:              scopes,
+			InvitationExpiresAt: invitationExpiresAt(v.ExpirationDate),
+			Expired:             types.BoolValue(expired),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
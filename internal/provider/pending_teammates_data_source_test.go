@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPendingTeammatesDataSource(t *testing.T) {
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccPendingTeammatesDataSourceConfig(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_pending_teammates.test", "pending_teammates.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_pending_teammates.test", "pending_teammates.0.email", email),
+					resource.TestCheckResourceAttr("data.sendgrid_pending_teammates.test", "pending_teammates.0.is_admin", "false"),
+					resource.TestCheckTypeSetElemAttr("data.sendgrid_pending_teammates.test", "pending_teammates.0.scopes.*", "user.profile.read"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_pending_teammates.test", "pending_teammates.0.invitation_expires_at", "sendgrid_teammate.test", "invitation_expires_at"),
+					resource.TestCheckResourceAttr("data.sendgrid_pending_teammates.test", "pending_teammates.0.expired", "false"),
+					resource.TestCheckResourceAttr("data.sendgrid_pending_teammates.expired", "pending_teammates.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPendingTeammatesDataSourceConfig(email string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_teammate" "test" {
+	email  = "%s"
+	scopes = ["user.profile.read"]
+}
+
+data "sendgrid_pending_teammates" "test" {
+	email_regex = "^${sendgrid_teammate.test.email}$"
+}
+
+data "sendgrid_pending_teammates" "expired" {
+	email_regex = "^${sendgrid_teammate.test.email}$"
+	expired     = true
+}
+`, email)
+}
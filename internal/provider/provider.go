@@ -5,21 +5,32 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/kenzo0107/sendgrid"
 )
 
 // Ensure sendgridProvider satisfies various provider interfaces.
 var _ provider.Provider = &sendgridProvider{}
+var _ provider.ProviderWithListResources = &sendgridProvider{}
+var _ provider.ProviderWithEphemeralResources = &sendgridProvider{}
 
 // sendgridProvider defines the provider implementation.
 type sendgridProvider struct {
@@ -34,6 +45,17 @@ type sendgridProviderModel struct {
 	APIKey  types.String `tfsdk:"api_key"`
 	Subuser types.String `tfsdk:"subuser"`
 	Region  types.String `tfsdk:"region"`
+	BaseURL types.String `tfsdk:"base_url"`
+
+	MaxRetries        types.Int64  `tfsdk:"max_retries"`
+	MinBackoff        types.String `tfsdk:"min_backoff"`
+	MaxBackoff        types.String `tfsdk:"max_backoff"`
+	RetryServerErrors types.Bool   `tfsdk:"retry_server_errors"`
+	RetryDeadline     types.String `tfsdk:"retry_deadline"`
+
+	RateLimit            types.Float64 `tfsdk:"rate_limit"`
+	RateLimitBurst       types.Int64   `tfsdk:"rate_limit_burst"`
+	RateLimitPerEndpoint types.Bool    `tfsdk:"rate_limit_per_endpoint"`
 }
 
 func (p *sendgridProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -57,6 +79,60 @@ func (p *sendgridProvider) Schema(ctx context.Context, req provider.SchemaReques
 				MarkdownDescription: "Region for Sendgrid API. May also be provided via SENDGRID_REGION environment variable. Valid values are `global` and `eu`, with `global` as the default.",
 				Optional:            true,
 			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL for Sendgrid API, including the `/v3` path. May also be provided via SENDGRID_BASE_URL environment variable. Takes precedence over `region`; intended for pointing the provider at a proxy or a test server.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times an API request is retried after it is rate limited or, with `retry_server_errors`, fails with a server or network error. Defaults to `5`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_backoff": schema.StringAttribute{
+				MarkdownDescription: "Wait before the first retry, as a duration such as `500ms` or `1s`. The wait doubles on every further retry. Defaults to `1s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringDuration(),
+				},
+			},
+			"max_backoff": schema.StringAttribute{
+				MarkdownDescription: "Maximum wait between two attempts, including the wait SendGrid asks for when rate limiting. Defaults to `60s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringDuration(),
+				},
+			},
+			"retry_server_errors": schema.BoolAttribute{
+				MarkdownDescription: "Whether to retry requests that fail with a 5xx response or a network error. `POST` requests are never retried on these errors, since the object may have been created anyway. Defaults to `true`.",
+				Optional:            true,
+			},
+			"retry_deadline": schema.StringAttribute{
+				MarkdownDescription: "Maximum total time spent on a single API request, retries included, as a duration such as `5m`. No retry is attempted that would end after the deadline. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringDuration(),
+				},
+			},
+			"rate_limit": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of API requests per second the provider sends, shared by all resources and data sources. Requests over the limit wait for their turn instead of being rejected by SendGrid. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests that may be sent at once before `rate_limit` applies. Defaults to `rate_limit` rounded up.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rate_limit_per_endpoint": schema.BoolAttribute{
+				MarkdownDescription: "Whether `rate_limit` applies to each endpoint family, such as `teammates` or `whitelabel/domains`, separately instead of to all requests together. SendGrid enforces its rate limits per endpoint. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -66,6 +142,7 @@ func (p *sendgridProvider) Configure(ctx context.Context, req provider.Configure
 	apiKey := os.Getenv("SENDGRID_API_KEY")
 	subuser := os.Getenv("SENDGRID_SUBUSER")
 	region := os.Getenv("SENDGRID_REGION")
+	baseURL := os.Getenv("SENDGRID_BASE_URL")
 
 	// Retrieve provider data from configuration
 	var config sendgridProviderModel
@@ -87,6 +164,32 @@ func (p *sendgridProvider) Configure(ctx context.Context, req provider.Configure
 		region = config.Region.ValueString()
 	}
 
+	if !config.BaseURL.IsNull() {
+		baseURL = config.BaseURL.ValueString()
+	}
+
+	retry := defaultRetryPolicy
+
+	if !config.MaxRetries.IsNull() {
+		retry.maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	if !config.MinBackoff.IsNull() {
+		retry.minBackoff, _ = time.ParseDuration(config.MinBackoff.ValueString())
+	}
+
+	if !config.MaxBackoff.IsNull() {
+		retry.maxBackoff, _ = time.ParseDuration(config.MaxBackoff.ValueString())
+	}
+
+	if !config.RetryServerErrors.IsNull() {
+		retry.retryServerErrors = config.RetryServerErrors.ValueBool()
+	}
+
+	if !config.RetryDeadline.IsNull() {
+		retry.deadline, _ = time.ParseDuration(config.RetryDeadline.ValueString())
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -112,65 +215,146 @@ func (p *sendgridProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if retry.minBackoff > retry.maxBackoff {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_backoff"),
+			"Invalid retry backoff",
+			"min_backoff must not be greater than max_backoff.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	opts := []sendgrid.Option{}
-	if subuser != "" {
-		opts = append(opts, sendgrid.OptionSubuser(subuser))
+	switch {
+	case baseURL != "":
+		baseURL = strings.TrimSuffix(baseURL, "/")
+	case region == "eu":
+		baseURL = sendgrid.BaseURLEU
+	default:
+		baseURL = sendgrid.BaseURLGlobal
 	}
 
-	switch region {
-	case "eu":
-		opts = append(opts, sendgrid.OptionBaseURL(sendgrid.BaseURLEU))
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Invalid SendGrid Base URL",
+			fmt.Sprintf("The provider cannot parse the SendGrid base URL %q: %s", baseURL, err),
+		)
+		return
+	}
+
+	var httpClient httpDoer = &http.Client{}
+	if rps := config.RateLimit.ValueFloat64(); rps > 0 {
+		httpClient = newRateLimitedHTTPClient(
+			httpClient,
+			rps,
+			int(config.RateLimitBurst.ValueInt64()),
+			config.RateLimitPerEndpoint.ValueBool(),
+			u.Path,
+		)
+	}
+
+	opts := []sendgrid.Option{
+		sendgrid.OptionBaseURL(baseURL),
+		// Retries go through the rate limiter too, so that they wait for
+		// their turn like any other request.
+		sendgrid.OptionHTTPClient(&onBehalfOfHTTPClient{
+			next: &retryingHTTPClient{
+				next:   httpClient,
+				policy: retry,
+			},
+		}),
+	}
+	if subuser != "" {
+		opts = append(opts, sendgrid.OptionSubuser(subuser))
 	}
 
 	client := sendgrid.New(apiKey, opts...)
 
-	// Make the SendGrid api key available during DataSource and Resource
-	// type Configure methods.
+	// Make the SendGrid api key available during DataSource, Resource,
+	// EphemeralResource and ListResource type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
 }
 
 func (p *sendgridProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		newTeammateResource,
+		newTeammateAccessRequestDecisionResource,
 		newAPIKeyResource,
 		newSubuserResource,
+		newSubuserMonitorResource,
+		newSubuserCreditsResource,
 		newSubuserWhitelabelDomainResource,
 		newSenderAuthenticationResource,
+		newSenderAuthenticationValidationResource,
 		newLinkBrandingResource,
+		newLinkBrandingValidationResource,
 		newSenderVerificationResource,
 		newUnsubscribeGroupResource,
+		newUnsubscribeGroupSuppressionsResource,
+		newGlobalUnsubscribesResource,
 		newTemplateResource,
 		newTemplateVersionResource,
 		newEnforceTLSResource,
 		newReverseDNSResource,
+		newReverseDNSValidationResource,
 		newSSOIntegrationResource,
 		newSSOCertificateResource,
 		newEventWebhookResource,
 		newInboundParseWebhookResource,
 		newSSOTeammateResource,
 		newClickTrackingSettingsResource,
+		newOpenTrackingSettingsResource,
+		newSubscriptionTrackingSettingsResource,
+		newGoogleAnalyticsSettingsResource,
 		newBounceSettingsResource,
 		newAlertResource,
 		newDesignResource,
 		newIPPoolResource,
+		newIPAccessAllowListResource,
+		newAddressAllowListSettingsResource,
+		newBCCSettingsResource,
+		newBypassListManagementSettingsResource,
+		newBypassSpamManagementSettingsResource,
+		newBypassBounceManagementSettingsResource,
+		newBypassUnsubscribeManagementSettingsResource,
+		newFooterSettingsResource,
+		newForwardBounceSettingsResource,
+		newForwardSpamSettingsResource,
+		newTemplateSettingsResource,
+		newPlainContentSettingsResource,
 	}
 }
 
 func (p *sendgridProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newTeammateDataSource,
+		newTeammatesDataSource,
+		newPendingTeammatesDataSource,
+		newTeammateAccessRequestsDataSource,
 		newAPIKeyDataSource,
+		newAPIKeysDataSource,
 		newSubuserDataSource,
+		newSubusersDataSource,
+		newSubuserCreditsDataSource,
 		newSenderAuthenticationDataSource,
+		newSenderAuthenticationsDataSource,
 		newLinkBrandingDataSource,
 		newSenderVerificationDataSource,
 		newUnsubscribeGroupDataSource,
+		newUnsubscribeGroupsDataSource,
+		newBouncesDataSource,
+		newBlocksDataSource,
+		newSpamReportsDataSource,
+		newInvalidEmailsDataSource,
 		newTemplateDataSource,
+		newTemplatesDataSource,
 		newTemplateVersionDataSource,
 		newEnforceTLSDataSource,
 		newReverseDNSDataSource,
@@ -179,61 +363,51 @@ func (p *sendgridProvider) DataSources(ctx context.Context) []func() datasource.
 		newEventWebhookDataSource,
 		newInboundParseWebhookDataSource,
 		newClickTrackingSettingsDataSource,
+		newOpenTrackingSettingsDataSource,
+		newSubscriptionTrackingSettingsDataSource,
+		newGoogleAnalyticsSettingsDataSource,
 		newBounceSettingsDataSource,
 		newAlertDataSource,
 		newDesignDataSource,
+		newDesignsDataSource,
 		newIPPoolDataSource,
+		newAddressAllowListSettingsDataSource,
+		newBCCSettingsDataSource,
+		newBypassListManagementSettingsDataSource,
+		newBypassSpamManagementSettingsDataSource,
+		newBypassBounceManagementSettingsDataSource,
+		newBypassUnsubscribeManagementSettingsDataSource,
+		newFooterSettingsDataSource,
+		newForwardBounceSettingsDataSource,
+		newForwardSpamSettingsDataSource,
+		newTemplateSettingsDataSource,
+		newPlainContentSettingsDataSource,
+		newScopesDataSource,
+		newScopePresetDataSource,
 	}
 }
 
-func New(version string) func() provider.Provider {
-	return func() provider.Provider {
-		return &sendgridProvider{
-			version: version,
-		}
+func (p *sendgridProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newAPIKeyEphemeralResource,
 	}
 }
 
-func retryOnRateLimit(ctx context.Context, f func() (interface{}, error)) (resp interface{}, err error) {
-	maxRetries := 5
-	baseDelay := 1 * time.Second
-	maxDelay := 60 * time.Second
-
-	for retry := 0; retry < maxRetries; retry++ {
-		resp, err = f()
-		if err == nil {
-			return resp, nil
-		}
+func (p *sendgridProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		newTeammateListResource,
+		newAPIKeyListResource,
+		newSubuserListResource,
+		newSenderAuthenticationListResource,
+		newTemplateListResource,
+		newEventWebhookListResource,
+	}
+}
 
-		if rle, ok := err.(*sendgrid.RateLimitedError); ok {
-			var waitTime time.Duration
-			if rle.RetryAfter > 0 {
-				waitTime = rle.RetryAfter
-				waitTime += time.Duration(retry*100) * time.Millisecond
-			} else {
-				waitTime = baseDelay * (1 << uint(retry))
-			}
-
-			if waitTime > maxDelay {
-				waitTime = maxDelay
-			}
-
-			tflog.Info(ctx, "Rate limited, retrying", map[string]interface{}{
-				"retry_attempt": retry + 1,
-				"max_retries":   maxRetries,
-				"wait_seconds":  waitTime.Seconds(),
-			})
-
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(waitTime):
-				continue
-			}
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &sendgridProvider{
+			version: version,
 		}
-
-		return resp, err
 	}
-
-	return resp, err
 }
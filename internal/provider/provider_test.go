@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/kenzo0107/terraform-provider-sendgrid/internal/sendgridtest"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -19,6 +20,46 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"sendgrid": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccServer is the fake SendGrid API the acceptance tests run against
+// when SENDGRID_API_KEY is not set. It is nil when testing against a real
+// account.
+var testAccServer *sendgridtest.Server
+
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") != "" && os.Getenv("SENDGRID_API_KEY") == "" {
+		testAccServer = sendgridtest.NewServer()
+
+		for k, v := range map[string]string{
+			"SENDGRID_API_KEY":               "SG.offline",
+			"SENDGRID_BASE_URL":              testAccServer.BaseURL(),
+			"IP_ADDRESS":                     sendgridtest.DefaultIP,
+			"INBOUND_PARSE_WEBHOOK_HOSTNAME": "parse.example.com",
+		} {
+			if os.Getenv(k) == "" {
+				_ = os.Setenv(k, v)
+			}
+		}
+	}
+
+	code := m.Run()
+
+	if testAccServer != nil {
+		testAccServer.Close()
+	}
+	os.Exit(code)
+}
+
+// testAccOffline skips the test unless it runs against the fake SendGrid
+// API, for tests that need to change server state behind Terraform's back.
+func testAccOffline(t *testing.T) *sendgridtest.Server {
+	t.Helper()
+
+	if testAccServer == nil {
+		t.Skip("requires the offline SendGrid API; unset SENDGRID_API_KEY to run")
+	}
+	return testAccServer
+}
+
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 
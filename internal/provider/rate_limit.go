@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// groupedEndpoints are path prefixes whose second segment names the endpoint
+// family, because the first one only groups unrelated APIs together.
+var groupedEndpoints = []string{
+	"asm",
+	"mail_settings",
+	"sso",
+	"suppression",
+	"tracking_settings",
+	"user",
+	"whitelabel",
+}
+
+// endpointFamily returns the endpoint family a request path belongs to,
+// relative to basePath: "teammates" for /v3/teammates/pending and
+// "whitelabel/domains" for /v3/whitelabel/domains/1/validate.
+func endpointFamily(basePath, urlPath string) string {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(urlPath, basePath), "/"), "/")
+	if len(segments) > 1 {
+		for _, g := range groupedEndpoints {
+			if segments[0] == g {
+				return segments[0] + "/" + segments[1]
+			}
+		}
+	}
+	return segments[0]
+}
+
+// rateLimitedHTTPClient holds every request sent through next until a token
+// bucket allows it, so that concurrent resources do not run into SendGrid's
+// rate limits in the first place.
+type rateLimitedHTTPClient struct {
+	next httpDoer
+
+	requestsPerSecond float64
+	burst             int
+	// perEndpoint gives every endpoint family its own bucket, mirroring how
+	// SendGrid enforces its limits. Otherwise a single bucket is shared by
+	// all requests.
+	perEndpoint bool
+	basePath    string
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newRateLimitedHTTPClient(next httpDoer, requestsPerSecond float64, burst int, perEndpoint bool, basePath string) *rateLimitedHTTPClient {
+	if burst < 1 {
+		burst = max(1, int(math.Ceil(requestsPerSecond)))
+	}
+	return &rateLimitedHTTPClient{
+		next:              next,
+		requestsPerSecond: requestsPerSecond,
+		burst:             burst,
+		perEndpoint:       perEndpoint,
+		basePath:          basePath,
+		limiters:          map[string]*rate.Limiter{},
+	}
+}
+
+func (c *rateLimitedHTTPClient) limiter(req *http.Request) *rate.Limiter {
+	key := ""
+	if c.perEndpoint {
+		key = endpointFamily(c.basePath, req.URL.Path)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	l, ok := c.limiters[key]
+	if !ok {
+		l = rate.NewLimiter(rate.Limit(c.requestsPerSecond), c.burst)
+		c.limiters[key] = l
+	}
+	return l
+}
+
+func (c *rateLimitedHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if err := c.limiter(req).Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return c.next.Do(req)
+}
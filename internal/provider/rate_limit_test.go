@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEndpointFamily(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"/v3/teammates":                     "teammates",
+		"/v3/teammates/pending":             "teammates",
+		"/v3/teammates/someone":             "teammates",
+		"/v3/whitelabel/domains/1/validate": "whitelabel/domains",
+		"/v3/whitelabel/links":              "whitelabel/links",
+		"/v3/user/settings/enforced_tls":    "user/settings",
+		"/v3/templates/d-123/versions":      "templates",
+		"/v3/whitelabel":                    "whitelabel",
+	}
+
+	for urlPath, want := range tests {
+		if got := endpointFamily("/v3", urlPath); got != want {
+			t.Errorf("endpointFamily(%q) = %q, want %q", urlPath, got, want)
+		}
+	}
+}
+
+func TestRateLimitedHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		perEndpoint bool
+		paths       []string
+		// wantBlocked is the index of the first request that has to wait
+		// for a token, or -1 if none does.
+		wantBlocked int
+	}{
+		"requests share one bucket": {
+			perEndpoint: false,
+			paths:       []string{"/v3/teammates", "/v3/api_keys"},
+			wantBlocked: 1,
+		},
+		"endpoint families have their own bucket": {
+			perEndpoint: true,
+			paths:       []string{"/v3/teammates", "/v3/api_keys", "/v3/whitelabel/domains", "/v3/whitelabel/links"},
+			wantBlocked: -1,
+		},
+		"requests to one family share its bucket": {
+			perEndpoint: true,
+			paths:       []string{"/v3/teammates", "/v3/api_keys", "/v3/teammates/pending"},
+			wantBlocked: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			// One request per minute with a burst of one: the second request
+			// to a bucket would have to wait far longer than the deadline.
+			client := newRateLimitedHTTPClient(server.Client(), 1.0/60, 1, test.perEndpoint, "/v3")
+
+			for i, p := range test.paths {
+				ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+				req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+p, nil)
+				if err != nil {
+					cancel()
+					t.Fatal(err)
+				}
+				resp, err := client.Do(req)
+				cancel()
+
+				if i == test.wantBlocked {
+					if err == nil {
+						_ = resp.Body.Close()
+						t.Fatalf("request %d to %s was not rate limited", i, p)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("request %d to %s: %s", i, p, err)
+				}
+				_ = resp.Body.Close()
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// retryPolicy controls how API requests are retried. It is configured on the
+// provider block and applied to every request the SendGrid client sends, so
+// resources and data sources do not need to handle retries themselves.
+type retryPolicy struct {
+	// maxRetries is the number of times a request is retried after the first
+	// attempt.
+	maxRetries int
+	// minBackoff is the wait before the first retry. It doubles on every
+	// subsequent retry up to maxBackoff.
+	minBackoff time.Duration
+	// maxBackoff caps the wait between two attempts, including the wait
+	// SendGrid asks for when rate limiting.
+	maxBackoff time.Duration
+	// retryServerErrors also retries 5xx responses and network errors.
+	retryServerErrors bool
+	// deadline bounds the total time spent on a request, retries included.
+	// Zero means no deadline.
+	deadline time.Duration
+}
+
+var defaultRetryPolicy = retryPolicy{
+	maxRetries:        5,
+	minBackoff:        1 * time.Second,
+	maxBackoff:        60 * time.Second,
+	retryServerErrors: true,
+}
+
+// backoff returns the exponential backoff before the given retry, counting
+// from zero.
+func (p retryPolicy) backoff(retry int) time.Duration {
+	d := p.minBackoff
+	for i := 0; i < retry && d < p.maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, p.maxBackoff)
+}
+
+// httpDoer is the interface the SendGrid client uses to send requests.
+type httpDoer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// retryingHTTPClient sends requests through next and retries them according
+// to policy.
+//
+// Rate limited requests are always safe to retry because SendGrid rejected
+// them without acting on them. Server and network errors are only retried for
+// methods other than POST, since a failed create may still have gone through.
+type retryingHTTPClient struct {
+	next   httpDoer
+	policy retryPolicy
+}
+
+func (c *retryingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	start := time.Now()
+
+	for retry := 0; ; retry++ {
+		if retry > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := c.next.Do(req)
+
+		wait, reason, ok := c.retryAfter(req, resp, err, retry)
+		if !ok || retry >= c.policy.maxRetries {
+			return resp, err
+		}
+		if c.policy.deadline > 0 && time.Since(start)+wait > c.policy.deadline {
+			tflog.Warn(ctx, "Retry deadline exceeded, giving up", map[string]interface{}{
+				"method":   req.Method,
+				"url":      req.URL.String(),
+				"reason":   reason,
+				"attempts": retry + 1,
+				"deadline": c.policy.deadline.String(),
+			})
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		tflog.Info(ctx, "Request failed, retrying", map[string]interface{}{
+			"method":        req.Method,
+			"url":           req.URL.String(),
+			"reason":        reason,
+			"retry_attempt": retry + 1,
+			"max_retries":   c.policy.maxRetries,
+			"wait_seconds":  wait.Seconds(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// retryAfter reports whether the outcome of an attempt should be retried,
+// how long to wait first and why.
+func (c *retryingHTTPClient) retryAfter(req *http.Request, resp *http.Response, err error, retry int) (time.Duration, string, bool) {
+	if err != nil {
+		if errors.Is(err, req.Context().Err()) || !c.policy.retryServerErrors || req.Method == http.MethodPost {
+			return 0, "", false
+		}
+		return c.policy.backoff(retry), err.Error(), true
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		wait := c.policy.backoff(retry)
+		// SendGrid reports when the rate limit window resets as a Unix
+		// timestamp. Waiting until then avoids retrying into the same limit.
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			if d := time.Until(time.Unix(reset, 0)); d > 0 {
+				wait = min(d+time.Duration(retry*100)*time.Millisecond, c.policy.maxBackoff)
+			}
+		}
+		return wait, resp.Status, true
+	case resp.StatusCode >= 500 && c.policy.retryServerErrors && req.Method != http.MethodPost:
+		return c.policy.backoff(retry), resp.Status, true
+	}
+	return 0, "", false
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	p := retryPolicy{minBackoff: time.Second, maxBackoff: 5 * time.Second}
+
+	for retry, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := p.backoff(retry); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", retry, got, want)
+		}
+	}
+}
+
+func TestRetryingHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	policy := retryPolicy{
+		maxRetries:        3,
+		minBackoff:        time.Millisecond,
+		maxBackoff:        10 * time.Millisecond,
+		retryServerErrors: true,
+	}
+
+	tests := map[string]struct {
+		policy       retryPolicy
+		method       string
+		statuses     []int
+		wantStatus   int
+		wantAttempts int32
+	}{
+		"rate limited request is retried": {
+			policy:       policy,
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		"rate limited create is retried": {
+			policy:       policy,
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusCreated},
+			wantStatus:   http.StatusCreated,
+			wantAttempts: 2,
+		},
+		"server error is retried": {
+			policy:       policy,
+			method:       http.MethodPatch,
+			statuses:     []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		"server error on create is not retried": {
+			policy:       policy,
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusInternalServerError, http.StatusCreated},
+			wantStatus:   http.StatusInternalServerError,
+			wantAttempts: 1,
+		},
+		"server error is not retried when disabled": {
+			policy: func() retryPolicy {
+				p := policy
+				p.retryServerErrors = false
+				return p
+			}(),
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusInternalServerError, http.StatusOK},
+			wantStatus:   http.StatusInternalServerError,
+			wantAttempts: 1,
+		},
+		"client error is not retried": {
+			policy:       policy,
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusNotFound, http.StatusOK},
+			wantStatus:   http.StatusNotFound,
+			wantAttempts: 1,
+		},
+		"retries stop at max_retries": {
+			policy:       policy,
+			method:       http.MethodGet,
+			statuses:     []int{429, 429, 429, 429, 429, http.StatusOK},
+			wantStatus:   http.StatusTooManyRequests,
+			wantAttempts: 4,
+		},
+		"retries stop at the deadline": {
+			policy: func() retryPolicy {
+				p := policy
+				p.minBackoff = 50 * time.Millisecond
+				p.maxBackoff = 50 * time.Millisecond
+				p.deadline = 10 * time.Millisecond
+				return p
+			}(),
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			wantStatus:   http.StatusTooManyRequests,
+			wantAttempts: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				if body, _ := io.ReadAll(r.Body); string(body) != "payload" {
+					t.Errorf("attempt %d: body = %q, want %q", n, body, "payload")
+				}
+				w.WriteHeader(test.statuses[n-1])
+			}))
+			defer server.Close()
+
+			client := &retryingHTTPClient{next: server.Client(), policy: test.policy}
+
+			req, err := http.NewRequestWithContext(t.Context(), test.method, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = resp.Body.Close()
+
+			if resp.StatusCode != test.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, test.wantStatus)
+			}
+			if got := attempts.Load(); got != test.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, test.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryingHTTPClientCapsRateLimitReset(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			// SendGrid asks for an hour; max_backoff caps the wait.
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &retryingHTTPClient{
+		next:   server.Client(),
+		policy: retryPolicy{maxRetries: 1, minBackoff: time.Millisecond, maxBackoff: time.Millisecond},
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
@@ -36,6 +36,8 @@ type reverseDNSDataSourceModel struct {
 	Legacy                types.Bool   `tfsdk:"legacy"`
 	LastValidationAttempt types.Int64  `tfsdk:"last_validation_attempt"`
 	ARecord               types.Object `tfsdk:"a_record"`
+	Validate              types.Bool   `tfsdk:"validate"`
+	ValidationReason      types.String `tfsdk:"validation_failure_reason"`
 }
 
 func (d *reverseDNSDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -130,6 +132,14 @@ For more about Reverse DNS, see ["How to set up reverse DNS"](https://sendgrid.c
 				Computed:       true,
 				AttributeTypes: aRecordObjectAttribute,
 			},
+			"validate": schema.BoolAttribute{
+				MarkdownDescription: "Whether to ask SendGrid to validate the reverse DNS before reading it, so that `valid`, `a_record` and `last_validation_attempt` reflect the current DNS records. The reverse DNS is validated on every read.",
+				Optional:            true,
+			},
+			"validation_failure_reason": schema.StringAttribute{
+				MarkdownDescription: "Why SendGrid could not validate the A record when `validate` is set and the reverse DNS is not valid. It is empty when the reverse DNS is valid, and null when `validate` is not set.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -146,6 +156,24 @@ func (d *reverseDNSDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	id := s.ID.ValueString()
 	reverseDNSId, _ := strconv.ParseInt(id, 10, 64)
 
+	// The reason stays null unless the reverse DNS is validated, to tell it
+	// apart from a validation that did not fail.
+	reason := types.StringNull()
+	if s.Validate.ValueBool() {
+		v, err := d.client.ValidateReverseDNS(ctx, reverseDNSId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Validating reverseDNS",
+				fmt.Sprintf("Unable to validate reverseDNS (id: %v), got error: %s", id, err),
+			)
+			return
+		}
+		reason = types.StringValue("")
+		if !v.Valid {
+			reason = types.StringValue(v.ValidationResults.ARecordValidationResults.Reason)
+		}
+	}
+
 	o, err := d.client.GetReverseDNS(ctx, reverseDNSId)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -166,6 +194,8 @@ func (d *reverseDNSDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		Legacy:                types.BoolValue(o.Legacy),
 		LastValidationAttempt: types.Int64Value(o.LastValidationAttemptAt),
 		ARecord:               newARecord(o.ARecord),
+		Validate:              s.Validate,
+		ValidationReason:      reason,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
 	if resp.Diagnostics.HasError() {
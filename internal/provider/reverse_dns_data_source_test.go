@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReverseDNSDataSource(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "data.sendgrid_reverse_dns.test"
+
+	ip := os.Getenv("IP_ADDRESS")
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReverseDNSDataSourceConfig(ip, domain, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ip", ip),
+					resource.TestCheckResourceAttr(resourceName, "domain", domain),
+					resource.TestCheckResourceAttr(resourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(resourceName, "last_validation_attempt", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, "validation_failure_reason"),
+				),
+			},
+			// Validating reports why the A record is not valid
+			{
+				Config: testAccReverseDNSDataSourceConfig(ip, domain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "false"),
+					resource.TestMatchResourceAttr(resourceName, "validation_failure_reason", regexp.MustCompile(`^Expected A for "o1\.`)),
+				),
+			},
+			{
+				PreConfig: func() {
+					server.PublishDNSRecord("o1." + domain)
+				},
+				Config: testAccReverseDNSDataSourceConfig(ip, domain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(resourceName, "a_record.valid", "true"),
+					resource.TestCheckResourceAttr(resourceName, "validation_failure_reason", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccReverseDNSDataSourceConfig(ip, domain string, validate bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_reverse_dns" "test" {
+  ip        = "%s"
+  domain    = "%s"
+  subdomain = "o1"
+}
+
+data "sendgrid_reverse_dns" "test" {
+  id       = sendgrid_reverse_dns.test.id
+  validate = %t
+}
+`, ip, domain, validate)
+}
@@ -6,11 +6,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,6 +25,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &reverseDNSResource{}
 var _ resource.ResourceWithImportState = &reverseDNSResource{}
+var _ resource.ResourceWithIdentity = &reverseDNSResource{}
+var _ resource.ResourceWithModifyPlan = &reverseDNSResource{}
 
 func newReverseDNSResource() resource.Resource {
 	return &reverseDNSResource{}
@@ -42,6 +47,20 @@ type reverseDNSResourceModel struct {
 	Legacy                types.Bool   `tfsdk:"legacy"`
 	LastValidationAttempt types.Int64  `tfsdk:"last_validation_attempt"`
 	ARecord               types.Object `tfsdk:"a_record"`
+
+	WaitForValidation      types.Bool   `tfsdk:"wait_for_validation"`
+	ValidationTimeout      types.String `tfsdk:"validation_timeout"`
+	ValidationPollInterval types.String `tfsdk:"validation_poll_interval"`
+}
+
+type reverseDNSIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (m reverseDNSResourceModel) identity() reverseDNSIdentityModel {
+	return reverseDNSIdentityModel{
+		ID: m.ID,
+	}
 }
 
 var aRecordObjectAttribute = map[string]attr.Type{
@@ -136,6 +155,18 @@ For more about Reverse DNS, see ["How to set up reverse DNS"](https://sendgrid.c
 			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, validationAttributes("the reverse DNS", "sendgrid_reverse_dns_validation"))
+}
+
+func (r *reverseDNSResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the reverse DNS record.",
+				RequiredForImport: true,
+			},
+		},
+	}
 }
 
 func (r *reverseDNSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -157,6 +188,10 @@ func (r *reverseDNSResource) Configure(ctx context.Context, req resource.Configu
 	r.client = client
 }
 
+func (r *reverseDNSResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planRevalidation(ctx, req, resp, path.Root("last_validation_attempt"), path.Root("a_record"))
+}
+
 func (r *reverseDNSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan reverseDNSResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -173,9 +208,7 @@ func (r *reverseDNSResource) Create(ctx context.Context, req resource.CreateRequ
 		input.Subdomain = plan.Subdomain.ValueString()
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateReverseDNS(ctx, input)
-	})
+	o, err := r.client.CreateReverseDNS(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating reverseDNS",
@@ -184,15 +217,6 @@ func (r *reverseDNSResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateReverseDNS)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating reverseDNS",
-			"Failed to assert type *sendgrid.OutputCreateReverseDNS",
-		)
-		return
-	}
-
 	plan = reverseDNSResourceModel{
 		ID:                    types.StringValue(strconv.FormatInt(o.ID, 10)),
 		IP:                    types.StringValue(o.IP),
@@ -204,8 +228,20 @@ func (r *reverseDNSResource) Create(ctx context.Context, req resource.CreateRequ
 		Legacy:                types.BoolValue(o.Legacy),
 		LastValidationAttempt: types.Int64Value(o.LastValidationAttemptAt),
 		ARecord:               newARecord(o.ARecord),
+
+		WaitForValidation:      plan.WaitForValidation,
+		ValidationTimeout:      plan.ValidationTimeout,
+		ValidationPollInterval: plan.ValidationPollInterval,
+	}
+
+	if plan.WaitForValidation.ValueBool() && !o.Valid {
+		var validation diag.Diagnostics
+		r.waitForValidation(ctx, &plan, &validation)
+		resp.Diagnostics.Append(createValidationDiagnostics(validation, "the reverse DNS", "sendgrid_reverse_dns_validation")...)
 	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -241,18 +277,41 @@ func (r *reverseDNSResource) Read(ctx context.Context, req resource.ReadRequest,
 		Legacy:                types.BoolValue(o.Legacy),
 		LastValidationAttempt: types.Int64Value(o.LastValidationAttemptAt),
 		ARecord:               newARecord(o.ARecord),
+
+		WaitForValidation:      state.WaitForValidation,
+		ValidationTimeout:      state.ValidationTimeout,
+		ValidationPollInterval: state.ValidationPollInterval,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// Update only applies the validation settings, as everything else about a
+// reverse DNS requires replacing it.
 func (r *reverseDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Updating reverseDNS",
-		"cannot update reverseDNS, it is immutable",
-	)
+	var plan, state reverseDNSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.WaitForValidation = plan.WaitForValidation
+	state.ValidationTimeout = plan.ValidationTimeout
+	state.ValidationPollInterval = plan.ValidationPollInterval
+
+	if state.WaitForValidation.ValueBool() && !state.Valid.ValueBool() {
+		r.waitForValidation(ctx, &state, &resp.Diagnostics)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *reverseDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -265,9 +324,7 @@ func (r *reverseDNSResource) Delete(ctx context.Context, req resource.DeleteRequ
 	reverseDNSID := state.ID.ValueString()
 	id, _ := strconv.ParseInt(reverseDNSID, 10, 64)
 
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteReverseDNS(ctx, id)
-	})
+	err := r.client.DeleteReverseDNS(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting reverseDNS",
@@ -280,8 +337,10 @@ func (r *reverseDNSResource) Delete(ctx context.Context, req resource.DeleteRequ
 func (r *reverseDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data reverseDNSResourceModel
 
-	reverseDNSID := req.ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	reverseDNSID := importIDOrIdentity(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	id, _ := strconv.ParseInt(reverseDNSID, 10, 64)
 
@@ -305,13 +364,71 @@ func (r *reverseDNSResource) ImportState(ctx context.Context, req resource.Impor
 		Legacy:                types.BoolValue(o.Legacy),
 		LastValidationAttempt: types.Int64Value(o.LastValidationAttemptAt),
 		ARecord:               newARecord(o.ARecord),
+
+		WaitForValidation:      types.BoolValue(false),
+		ValidationTimeout:      types.StringValue(defaultValidationTimeout),
+		ValidationPollInterval: types.StringValue(defaultValidationPollInterval),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// waitForValidation validates the reverse DNS until it is valid or the
+// configured timeout elapses, and refreshes the validation results in data.
+// A failing A record is reported as an error, after which data is still worth
+// saving.
+func (r *reverseDNSResource) waitForValidation(ctx context.Context, data *reverseDNSResourceModel, diags *diag.Diagnostics) {
+	id, _ := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	timeout, interval := validationSettings(data.ValidationTimeout, data.ValidationPollInterval)
+
+	failures, err := waitForValidation(ctx, interval, timeout, func(ctx context.Context) (bool, []validationFailure, error) {
+		return validateReverseDNS(ctx, r.client, id)
+	})
+	if err != nil {
+		diags.AddError(
+			"Validating reverseDNS",
+			fmt.Sprintf("Unable to validate reverseDNS (id: %v), got error: %s", id, err),
+		)
+		return
+	}
+
+	o, err := r.client.GetReverseDNS(ctx, id)
+	if err != nil {
+		diags.AddError(
+			"Validating reverseDNS",
+			fmt.Sprintf("Unable to read reverseDNS (id: %v), got error: %s", id, err),
+		)
+		return
+	}
+	data.Valid = types.BoolValue(o.Valid)
+	data.LastValidationAttempt = types.Int64Value(o.LastValidationAttemptAt)
+	data.ARecord = newARecord(o.ARecord)
+
+	if len(failures) > 0 {
+		diags.AddError(
+			"Validating reverseDNS",
+			fmt.Sprintf("The reverse DNS of IP %s (id: %v) was not valid after %s:\n%s", data.IP.ValueString(), id, timeout, formatValidationFailures(failures)),
+		)
+	}
+}
+
+// validateReverseDNS asks SendGrid to validate the A record of the reverse DNS
+// once.
+func validateReverseDNS(ctx context.Context, client *sendgrid.Client, id int64) (bool, []validationFailure, error) {
+	o, err := client.ValidateReverseDNS(ctx, id)
+	if err != nil {
+		return false, nil, err
+	}
+	var failures []validationFailure
+	if result := o.ValidationResults.ARecordValidationResults; !result.Valid && result.Reason != "" {
+		failures = append(failures, validationFailure{record: "a_record", reason: result.Reason})
+	}
+	return o.Valid, failures, nil
+}
+
 func convertUsersToSetType(users []*sendgrid.User) basetypes.SetValue {
 	var r []attr.Value
 
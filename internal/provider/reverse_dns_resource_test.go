@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccReverseDNSResource(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_reverse_dns.test"
+
+	ip := os.Getenv("IP_ADDRESS")
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccReverseDNSResourceConfig(ip, domain, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "ip", ip),
+					resource.TestCheckResourceAttr(resourceName, "rdns", "o1."+domain),
+					resource.TestCheckResourceAttr(resourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(resourceName, "a_record.host", "o1."+domain),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validation_timeout", "validation_poll_interval"},
+			},
+			// Waiting times out while the A record is missing
+			{
+				Config:      testAccReverseDNSResourceConfig(ip, domain, true),
+				ExpectError: regexp.MustCompile(`(?s)valid after 1s:.*a_record: Expected A`),
+			},
+			// Validation is retried once the A record is published
+			{
+				PreConfig: func() {
+					server.PublishDNSRecord("o1." + domain)
+				},
+				Config: testAccReverseDNSResourceConfig(ip, domain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(resourceName, "a_record.valid", "true"),
+					resource.TestCheckResourceAttrWith(resourceName, "last_validation_attempt", func(v string) error {
+						if v == "0" {
+							return fmt.Errorf("expected a validation attempt")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccReverseDNSResource_waitForValidationOnCreate(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_reverse_dns.test"
+
+	ip := os.Getenv("IP_ADDRESS")
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Waiting times out on create, which only warns, so the reverse DNS
+			// is saved as not valid and the next plan validates it again
+			{
+				Config: testAccReverseDNSResourceConfig(ip, domain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "false"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// The reverse DNS is not replaced, but validated again
+			{
+				PreConfig: func() {
+					server.PublishDNSRecord("o1." + domain)
+				},
+				Config: testAccReverseDNSResourceConfig(ip, domain, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReverseDNSResourceConfig(ip, domain string, wait bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_reverse_dns" "test" {
+  ip                       = "%s"
+  domain                   = "%s"
+  subdomain                = "o1"
+  wait_for_validation      = %t
+  validation_timeout       = "1s"
+  validation_poll_interval = "100ms"
+}
+`, ip, domain, wait)
+}
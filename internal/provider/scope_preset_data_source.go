@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &scopePresetDataSource{}
+)
+
+func newScopePresetDataSource() datasource.DataSource {
+	return &scopePresetDataSource{}
+}
+
+type scopePresetDataSource struct{}
+
+type scopePresetDataSourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	ExtraScopes    types.Set    `tfsdk:"extra_scopes"`
+	ExcludedScopes types.Set    `tfsdk:"excluded_scopes"`
+	Scopes         types.Set    `tfsdk:"scopes"`
+}
+
+func (d *scopePresetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scope_preset"
+}
+
+func (d *scopePresetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Expands a named preset into its scopes, to be given to ` + "`sendgrid_api_key`" + `, ` + "`sendgrid_teammate`" + ` or ` + "`sendgrid_sso_teammate`" + `.
+
+The presets follow the personas of the SendGrid teammate permissions:
+
+- ` + "`accountant`" + `: manages billing and reads the usage of the account.
+- ` + "`developer`" + `: sends mail and manages the settings, templates, webhooks and domains an integration with SendGrid needs.
+- ` + "`mail_send_only`" + `: only sends mail.
+- ` + "`marketer`" + `: manages marketing campaigns, designs, templates and unsubscribe groups, and reads their statistics.
+- ` + "`observer`" + `: reads the statistics, email activity and content of the account, without any of its settings.
+- ` + "`read_only`" + `: reads everything a scope allows to read.
+
+The presets are defined by the provider and do not call SendGrid. They leave out the scopes SendGrid sets automatically and the ones that cannot be granted when inviting a teammate.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/ui/account-and-settings/teammate-permissions#persona-scopes).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the preset. One of " + flex.QuoteAndJoin(scopePresetNames()) + ".",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(scopePresetNames()...),
+				},
+			},
+			"extra_scopes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Scopes to add to the preset.",
+				Optional:            true,
+			},
+			"excluded_scopes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Scopes to remove from the preset. They are removed from `extra_scopes` too.",
+				Optional:            true,
+			},
+			"scopes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The scopes of the preset, with `extra_scopes` added and `excluded_scopes` removed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *scopePresetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state scopePresetDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopes := expandScopePreset(
+		state.Name.ValueString(),
+		flex.ExpandFrameworkStringSet(ctx, state.ExtraScopes),
+		flex.ExpandFrameworkStringSet(ctx, state.ExcludedScopes),
+	)
+
+	scopesSet, diags := types.SetValueFrom(ctx, types.StringType, scopes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Scopes = scopesSet
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// expandScopePreset returns the scopes of the named preset with extra added
+// and excluded removed, sorted.
+func expandScopePreset(name string, extra, excluded []string) []string {
+	scopes := []string{}
+	for _, s := range slices.Concat(scopePresets[name], extra) {
+		if slices.Contains(excluded, s) || slices.Contains(scopes, s) {
+			continue
+		}
+		scopes = append(scopes, s)
+	}
+	slices.Sort(scopes)
+	return scopes
+}
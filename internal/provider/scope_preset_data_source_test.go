@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccScopePresetDataSource(t *testing.T) {
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "sendgrid_scope_preset" "test" {
+	name = "administrator"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			// Read testing
+			{
+				Config: testAccScopePresetDataSourceConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.sendgrid_scope_preset.test", tfjsonpath.New("scopes"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("mail.send"),
+						knownvalue.StringExact("templates.read"),
+					})),
+					statecheck.ExpectKnownValue("sendgrid_api_key.test", tfjsonpath.New("scopes"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("mail.send"),
+						knownvalue.StringExact("templates.read"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccScopePresetDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+data "sendgrid_scope_preset" "test" {
+	name            = "mail_send_only"
+	extra_scopes    = ["templates.read", "user.profile.read"]
+	excluded_scopes = ["user.profile.read"]
+}
+
+resource "sendgrid_api_key" "test" {
+	name   = "%s"
+	scopes = data.sendgrid_scope_preset.test.scopes
+}
+`, name)
+}
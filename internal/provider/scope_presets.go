@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+)
+
+// scopePresets are the named sets of scopes the sendgrid_scope_preset data
+// source expands, after the personas of the SendGrid teammate permissions.
+// They leave out the scopes SendGrid sets automatically and the ones that
+// cannot be granted when inviting a teammate, so that every preset can be
+// used for API keys and teammates alike.
+//
+// see: https://www.twilio.com/docs/sendgrid/ui/account-and-settings/teammate-permissions#persona-scopes
+var scopePresets = map[string][]string{
+	// accountant manages billing and reads the usage of the account.
+	"accountant": {
+		"billing.read",
+		"billing.update",
+		"stats.global.read",
+		"stats.read",
+		"subusers.credits.read",
+		"subusers.read",
+		"user.account.read",
+		"user.credits.read",
+		"user.profile.read",
+		"user.timezone.read",
+		"user.username.read",
+	},
+	// developer sends mail and manages the settings, templates, webhooks and
+	// domains an integration with SendGrid needs.
+	"developer": {
+		"alerts.create",
+		"alerts.delete",
+		"alerts.read",
+		"alerts.update",
+		"api_keys.create",
+		"api_keys.delete",
+		"api_keys.read",
+		"api_keys.update",
+		"asm.groups.create",
+		"asm.groups.delete",
+		"asm.groups.read",
+		"asm.groups.update",
+		"categories.create",
+		"categories.delete",
+		"categories.read",
+		"categories.stats.read",
+		"categories.update",
+		"design_library.read",
+		"email_activity.read",
+		"ips.assigned.read",
+		"ips.pools.create",
+		"ips.pools.delete",
+		"ips.pools.read",
+		"ips.pools.update",
+		"ips.read",
+		"mail.batch.create",
+		"mail.batch.delete",
+		"mail.batch.read",
+		"mail.batch.update",
+		"mail.send",
+		"mail_settings.address_whitelist.read",
+		"mail_settings.address_whitelist.update",
+		"mail_settings.bounce_purge.read",
+		"mail_settings.bounce_purge.update",
+		"mail_settings.footer.read",
+		"mail_settings.footer.update",
+		"mail_settings.forward_bounce.read",
+		"mail_settings.forward_bounce.update",
+		"mail_settings.forward_spam.read",
+		"mail_settings.forward_spam.update",
+		"mail_settings.plain_content.read",
+		"mail_settings.plain_content.update",
+		"mail_settings.read",
+		"mail_settings.template.read",
+		"mail_settings.template.update",
+		"partner_settings.new_relic.read",
+		"partner_settings.new_relic.update",
+		"partner_settings.read",
+		"stats.global.read",
+		"stats.read",
+		"suppression.blocks.create",
+		"suppression.blocks.delete",
+		"suppression.blocks.read",
+		"suppression.bounces.create",
+		"suppression.bounces.delete",
+		"suppression.bounces.read",
+		"suppression.create",
+		"suppression.delete",
+		"suppression.invalid_emails.create",
+		"suppression.invalid_emails.delete",
+		"suppression.invalid_emails.read",
+		"suppression.read",
+		"suppression.spam_reports.create",
+		"suppression.spam_reports.delete",
+		"suppression.spam_reports.read",
+		"suppression.unsubscribes.create",
+		"suppression.unsubscribes.delete",
+		"suppression.unsubscribes.read",
+		"templates.create",
+		"templates.delete",
+		"templates.read",
+		"templates.update",
+		"templates.versions.activate.create",
+		"templates.versions.create",
+		"templates.versions.delete",
+		"templates.versions.read",
+		"templates.versions.update",
+		"tracking_settings.click.read",
+		"tracking_settings.click.update",
+		"tracking_settings.google_analytics.read",
+		"tracking_settings.google_analytics.update",
+		"tracking_settings.open.read",
+		"tracking_settings.open.update",
+		"tracking_settings.read",
+		"tracking_settings.subscription.read",
+		"tracking_settings.subscription.update",
+		"user.account.read",
+		"user.profile.read",
+		"user.scheduled_sends.create",
+		"user.scheduled_sends.delete",
+		"user.scheduled_sends.read",
+		"user.scheduled_sends.update",
+		"user.settings.enforced_tls.read",
+		"user.settings.enforced_tls.update",
+		"user.timezone.read",
+		"user.username.read",
+		"user.webhooks.event.settings.read",
+		"user.webhooks.event.settings.update",
+		"user.webhooks.event.test.create",
+		"user.webhooks.parse.settings.create",
+		"user.webhooks.parse.settings.delete",
+		"user.webhooks.parse.settings.read",
+		"user.webhooks.parse.settings.update",
+		"user.webhooks.parse.stats.read",
+		"whitelabel.create",
+		"whitelabel.delete",
+		"whitelabel.read",
+		"whitelabel.update",
+	},
+	// mail_send_only only sends mail, as an application usually does.
+	"mail_send_only": {
+		"mail.send",
+	},
+	// marketer manages marketing campaigns, designs, templates and
+	// unsubscribe groups, and reads their statistics.
+	"marketer": {
+		"asm.groups.create",
+		"asm.groups.delete",
+		"asm.groups.read",
+		"asm.groups.update",
+		"categories.create",
+		"categories.delete",
+		"categories.read",
+		"categories.stats.read",
+		"categories.update",
+		"design_library.create",
+		"design_library.delete",
+		"design_library.read",
+		"design_library.update",
+		"email_activity.read",
+		"marketing.automation.read",
+		"marketing.read",
+		"marketing_campaigns.create",
+		"marketing_campaigns.delete",
+		"marketing_campaigns.read",
+		"marketing_campaigns.update",
+		"stats.global.read",
+		"stats.read",
+		"suppression.read",
+		"suppression.unsubscribes.create",
+		"suppression.unsubscribes.delete",
+		"suppression.unsubscribes.read",
+		"templates.create",
+		"templates.delete",
+		"templates.read",
+		"templates.update",
+		"templates.versions.activate.create",
+		"templates.versions.create",
+		"templates.versions.delete",
+		"templates.versions.read",
+		"templates.versions.update",
+		"user.account.read",
+		"user.profile.read",
+		"user.timezone.read",
+		"user.username.read",
+	},
+	// observer reads the statistics, email activity and content of the
+	// account, without any of its settings.
+	"observer": {
+		"asm.groups.read",
+		"browsers.stats.read",
+		"categories.read",
+		"categories.stats.read",
+		"clients.stats.read",
+		"design_library.read",
+		"devices.stats.read",
+		"email_activity.read",
+		"geo.stats.read",
+		"mailbox_providers.stats.read",
+		"marketing.automation.read",
+		"marketing.read",
+		"marketing_campaigns.read",
+		"stats.global.read",
+		"stats.read",
+		"subusers.stats.read",
+		"templates.read",
+		"templates.versions.read",
+		"user.account.read",
+		"user.profile.read",
+		"user.timezone.read",
+		"user.username.read",
+	},
+	// read_only reads everything a scope allows to read.
+	"read_only": {
+		"access_settings.activity.read",
+		"access_settings.whitelist.read",
+		"alerts.read",
+		"api_keys.read",
+		"asm.groups.read",
+		"billing.read",
+		"browsers.stats.read",
+		"categories.read",
+		"categories.stats.read",
+		"clients.stats.read",
+		"design_library.read",
+		"devices.stats.read",
+		"email_activity.read",
+		"geo.stats.read",
+		"ips.assigned.read",
+		"ips.pools.read",
+		"ips.read",
+		"mail.batch.read",
+		"mail_settings.address_whitelist.read",
+		"mail_settings.bounce_purge.read",
+		"mail_settings.footer.read",
+		"mail_settings.forward_bounce.read",
+		"mail_settings.forward_spam.read",
+		"mail_settings.plain_content.read",
+		"mail_settings.read",
+		"mail_settings.template.read",
+		"mailbox_providers.stats.read",
+		"marketing.automation.read",
+		"marketing.read",
+		"marketing_campaigns.read",
+		"partner_settings.new_relic.read",
+		"partner_settings.read",
+		"sso.settings.read",
+		"stats.global.read",
+		"stats.read",
+		"subusers.credits.read",
+		"subusers.monitor.read",
+		"subusers.read",
+		"subusers.stats.read",
+		"suppression.blocks.read",
+		"suppression.bounces.read",
+		"suppression.invalid_emails.read",
+		"suppression.read",
+		"suppression.spam_reports.read",
+		"suppression.unsubscribes.read",
+		"teammates.read",
+		"templates.read",
+		"templates.versions.read",
+		"tracking_settings.click.read",
+		"tracking_settings.google_analytics.read",
+		"tracking_settings.open.read",
+		"tracking_settings.read",
+		"tracking_settings.subscription.read",
+		"user.account.read",
+		"user.credits.read",
+		"user.email.read",
+		"user.multifactor_authentication.read",
+		"user.profile.read",
+		"user.scheduled_sends.read",
+		"user.settings.enforced_tls.read",
+		"user.timezone.read",
+		"user.username.read",
+		"user.webhooks.event.settings.read",
+		"user.webhooks.parse.settings.read",
+		"user.webhooks.parse.stats.read",
+		"whitelabel.read",
+	},
+}
+
+// scopePresetNames returns the names of the scope presets, sorted.
+func scopePresetNames() []string {
+	names := make([]string, 0, len(scopePresets))
+	for name := range scopePresets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
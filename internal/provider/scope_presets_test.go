@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kenzo0107/terraform-provider-sendgrid/internal/sendgridtest"
+)
+
+// TestScopePresets checks that every preset can be given to API keys and
+// teammates alike.
+func TestScopePresets(t *testing.T) {
+	t.Parallel()
+
+	for name, scopes := range scopePresets {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if len(scopes) == 0 {
+				t.Fatal("preset has no scopes")
+			}
+			if !slices.IsSorted(scopes) || len(slices.Compact(slices.Clone(scopes))) != len(scopes) {
+				t.Errorf("scopes are not sorted or have duplicates: %v", scopes)
+			}
+			for _, s := range scopes {
+				if !slices.Contains(sendgridtest.DefaultScopes, s) {
+					t.Errorf("%q is not a SendGrid scope", s)
+				}
+				if slices.Contains(defaultScopes, s) || slices.Contains(autoScopes, s) {
+					t.Errorf("%q is set automatically by SendGrid", s)
+				}
+				if slices.Contains(scopesBlockedDuringInvitation, s) {
+					t.Errorf("%q cannot be granted when inviting a teammate", s)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandScopePreset(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		extra    []string
+		excluded []string
+		want     []string
+	}{
+		"preset only": {
+			want: []string{"mail.send"},
+		},
+		"extra scopes are added once": {
+			extra: []string{"templates.read", "mail.send"},
+			want:  []string{"mail.send", "templates.read"},
+		},
+		"excluded scopes are removed from the preset and the extra scopes": {
+			extra:    []string{"templates.read", "stats.read"},
+			excluded: []string{"mail.send", "stats.read"},
+			want:     []string{"templates.read"},
+		},
+		"everything excluded": {
+			excluded: []string{"mail.send"},
+			want:     []string{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := expandScopePreset("mail_send_only", test.extra, test.excluded); !slices.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
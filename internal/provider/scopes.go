@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
+)
+
+// The sendgrid client does not cover the scopes of the API key it is
+// authenticated with, so they are read through its generic request helpers.
+
+type outputGetScopes struct {
+	Scopes []string `json:"scopes"`
+}
+
+// getScopes returns the scopes of the API key the client is authenticated
+// with, which are the only scopes it can grant to API keys and teammates.
+//
+// see: https://www.twilio.com/docs/sendgrid/api-reference/api-key-permissions/retrieve-a-list-of-scopes-for-which-this-user-has-access
+func getScopes(ctx context.Context, client *sendgrid.Client) ([]string, error) {
+	req, err := client.NewRequest("GET", "/scopes", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	o := new(outputGetScopes)
+	if err := client.Do(ctx, req, o); err != nil {
+		return nil, err
+	}
+	return o.Scopes, nil
+}
+
+// validateGrantableScopes fails for every scope the API key of the provider
+// cannot grant, suggesting the closest scope it can for likely typos. When the
+// scopes cannot be read, it only warns, so that the apply reports the error.
+func validateGrantableScopes(ctx context.Context, client *sendgrid.Client, p path.Path, scopes []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(scopes) == 0 {
+		return diags
+	}
+
+	grantable, err := getScopes(ctx, client)
+	if err != nil {
+		diags.AddAttributeWarning(
+			p,
+			"Unable to validate scopes",
+			fmt.Sprintf("Unable to read the scopes the API key of the provider can grant, got error: %s. The scopes are checked by SendGrid when applying instead.", err),
+		)
+		return diags
+	}
+
+	for _, s := range scopes {
+		if slices.Contains(grantable, s) {
+			continue
+		}
+		detail := fmt.Sprintf("%q is not a scope the API key of the provider can grant.", s)
+		if c := closestScope(s, grantable); c != "" {
+			detail += fmt.Sprintf(" Did you mean %q?", c)
+		} else {
+			detail += " Use the sendgrid_scopes data source to list the scopes it can grant."
+		}
+		diags.AddAttributeError(p, "Invalid scope", detail)
+	}
+	return diags
+}
+
+// closestScope returns the scope of candidates closest to scope, or an empty
+// string when none is close enough to be a likely typo.
+func closestScope(scope string, candidates []string) string {
+	// Allow about one edit for every three characters.
+	best, bestDistance := "", max(1, len(scope)/3)+1
+	for _, c := range candidates {
+		if d := levenshtein(scope, c); d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+	return best
+}
+
+// levenshtein returns the number of single character insertions, deletions
+// and substitutions needed to turn a into b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// changedScopes returns the planned scopes of an API key or a teammate, or
+// nil when they are unknown or unchanged, or the resource is destroyed.
+func changedScopes(ctx context.Context, req resource.ModifyPlanRequest) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if req.Plan.Raw.IsNull() {
+		return nil, diags
+	}
+
+	var plan, state types.Set
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("scopes"), &plan)...)
+	if diags.HasError() || plan.IsUnknown() {
+		return nil, diags
+	}
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("scopes"), &state)...)
+		if diags.HasError() || plan.Equal(state) {
+			return nil, diags
+		}
+	}
+
+	// Terraform plans again with the final values before applying.
+	for _, e := range plan.Elements() {
+		if e.IsUnknown() {
+			return nil, diags
+		}
+	}
+	return flex.ExpandFrameworkStringSet(ctx, plan), diags
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &scopesDataSource{}
+	_ datasource.DataSourceWithConfigure = &scopesDataSource{}
+)
+
+func newScopesDataSource() datasource.DataSource {
+	return &scopesDataSource{}
+}
+
+type scopesDataSource struct {
+	client *sendgrid.Client
+}
+
+type scopesDataSourceModel struct {
+	Scopes types.Set `tfsdk:"scopes"`
+}
+
+func (d *scopesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scopes"
+}
+
+func (d *scopesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *scopesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Retrieve the scopes the API key of the provider can grant.
+
+An API key or a teammate can only be given scopes the API key that creates it has. The ` + "`sendgrid_api_key`" + ` and ` + "`sendgrid_teammate`" + ` resources check their scopes against this list when planning.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/how-to-use-the-sendgrid-v3-api/authorization).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"scopes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The scopes the API key of the provider can grant. The scopes SendGrid sets automatically are left out, as they cannot be set manually.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *scopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state scopesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getScopes(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading scopes",
+			fmt.Sprintf("Unable to get scopes, got error: %s", err),
+		)
+		return
+	}
+
+	scopes := []string{}
+	for _, s := range o {
+		if slices.Contains(defaultScopes, s) || slices.Contains(autoScopes, s) {
+			continue
+		}
+		scopes = append(scopes, s)
+	}
+
+	scopesSet, diags := types.SetValueFrom(ctx, types.StringType, scopes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state = scopesDataSourceModel{
+		Scopes: scopesSet,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
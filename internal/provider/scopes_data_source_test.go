@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScopesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccScopesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.sendgrid_scopes.test", "scopes.*", "mail.send"),
+					resource.TestCheckTypeSetElemAttr("data.sendgrid_scopes.test", "scopes.*", "user.profile.read"),
+				),
+			},
+		},
+	})
+}
+
+const testAccScopesDataSourceConfig = `
+data "sendgrid_scopes" "test" {}
+`
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestClosestScope(t *testing.T) {
+	t.Parallel()
+
+	candidates := []string{
+		"mail.send",
+		"templates.read",
+		"templates.update",
+		"user.profile.read",
+		"user.profile.update",
+	}
+
+	tests := map[string]struct {
+		scope string
+		want  string
+	}{
+		"transposed letters": {
+			scope: "mail.sned",
+			want:  "mail.send",
+		},
+		"missing letter": {
+			scope: "template.read",
+			want:  "templates.read",
+		},
+		"closest of similar scopes": {
+			scope: "user.profile.updat",
+			want:  "user.profile.update",
+		},
+		"unrelated scope has no suggestion": {
+			scope: "billing.read",
+			want:  "",
+		},
+		"short scope has no suggestion": {
+			scope: "foo",
+			want:  "",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := closestScope(test.scope, candidates); got != test.want {
+				t.Errorf("closestScope(%q) = %q, want %q", test.scope, got, test.want)
+			}
+		})
+	}
+}
@@ -8,8 +8,12 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
 )
@@ -75,24 +79,40 @@ For more detailed information, please see the [SendGrid documentation](https://d
 		`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the authenticated domain.",
-				Required:            true,
+				MarkdownDescription: "The ID of the authenticated domain. Either `id`, or `domain` or `default` to look up the authenticated domain, must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("domain"), path.MatchRoot("default")),
+				},
 			},
 			"user_id": schema.Int64Attribute{
 				MarkdownDescription: "The ID of the user that this domain is associated with.",
 				Computed:            true,
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Domain being authenticated.",
+				MarkdownDescription: "Domain being authenticated. Set it instead of `id` to look up the authenticated domain of this domain.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
 			},
 			"subdomain": schema.StringAttribute{
-				MarkdownDescription: "The subdomain to use for this authenticated domain.",
+				MarkdownDescription: "The subdomain to use for this authenticated domain. Set it with `domain` to narrow the lookup.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
 			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "The username associated with this domain.",
+				MarkdownDescription: "The username associated with this domain. Set it with `domain` to look up the authenticated domain of a subuser.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
 			},
 			"ips": schema.SetAttribute{
 				ElementType:         types.StringType,
@@ -100,8 +120,12 @@ For more detailed information, please see the [SendGrid documentation](https://d
 				Computed:            true,
 			},
 			"default": schema.BoolAttribute{
-				MarkdownDescription: "Whether to use this authenticated domain as the fallback if no authenticated domains match the sender's domain.",
+				MarkdownDescription: "Whether to use this authenticated domain as the fallback if no authenticated domains match the sender's domain. Set it to `true` instead of `id` to look up the default authenticated domain.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
 			},
 			"legacy": schema.BoolAttribute{
 				MarkdownDescription: "Whether to use this authenticated domain as the fallback if no authenticated domains match the sender's domain.",
@@ -151,6 +175,41 @@ func (d *senderAuthenticationDataSource) Read(ctx context.Context, req datasourc
 	}
 
 	id := s.ID.ValueString()
+	if s.ID.IsNull() {
+		lookup := domainLookup{
+			Domain:    s.Domain,
+			Subdomain: s.Subdomain,
+			Username:  s.Username,
+			Default:   s.Default,
+		}
+		domains, err := listAllAuthenticatedDomains(ctx, d.client, sendgrid.InputGetAuthenticatedDomains{
+			Domain:   s.Domain.ValueString(),
+			Username: s.Username.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading sender authentication",
+				fmt.Sprintf("Unable to list authenticated domains, got error: %s", err),
+			)
+			return
+		}
+
+		var ids []string
+		for _, v := range domains {
+			if lookup.match(v.Domain, v.Subdomain, v.Username, v.Default) {
+				ids = append(ids, strconv.FormatInt(v.ID, 10))
+			}
+		}
+		id, err = singleID("authenticated domain", lookup.String(), ids)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading sender authentication",
+				fmt.Sprintf("Unable to look up authenticated domain, got error: %s", err),
+			)
+			return
+		}
+	}
+
 	domainId, _ := strconv.ParseInt(id, 10, 64)
 	o, err := d.client.GetAuthenticatedDomain(ctx, domainId)
 	if err != nil {
@@ -168,6 +227,7 @@ func (d *senderAuthenticationDataSource) Read(ctx context.Context, req datasourc
 
 	s.IPs = ips
 
+	s.ID = types.StringValue(id)
 	s.UserID = types.Int64Value(o.UserID)
 	s.Domain = types.StringValue(o.Domain)
 	s.Subdomain = types.StringValue(o.Subdomain)
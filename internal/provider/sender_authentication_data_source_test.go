@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -42,3 +43,103 @@ data "sendgrid_sender_authentication" "test" {
 }
 `, domain)
 }
+
+func TestAccSenderAuthenticationDataSource_domain(t *testing.T) {
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSenderAuthenticationDataSourceConfigDomain(domain, `
+data "sendgrid_sender_authentication" "by_subdomain" {
+	domain    = sendgrid_sender_authentication.second.domain
+	subdomain = sendgrid_sender_authentication.second.subdomain
+}
+
+data "sendgrid_sender_authentication" "by_default" {
+	domain  = sendgrid_sender_authentication.first.domain
+	default = true
+
+	depends_on = [sendgrid_sender_authentication.second]
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sendgrid_sender_authentication.by_subdomain", "id", "sendgrid_sender_authentication.second", "id"),
+					resource.TestCheckResourceAttr("data.sendgrid_sender_authentication.by_subdomain", "subdomain", "em2"),
+					resource.TestCheckResourceAttr("data.sendgrid_sender_authentication.by_subdomain", "default", "false"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_sender_authentication.by_default", "id", "sendgrid_sender_authentication.first", "id"),
+					resource.TestCheckResourceAttr("data.sendgrid_sender_authentication.by_default", "subdomain", "em1"),
+				),
+			},
+			// Several authenticated domains match the domain
+			{
+				Config: testAccSenderAuthenticationDataSourceConfigDomain(domain, `
+data "sendgrid_sender_authentication" "test" {
+	domain = sendgrid_sender_authentication.first.domain
+
+	depends_on = [sendgrid_sender_authentication.second]
+}
+`),
+				ExpectError: regexp.MustCompile(`2\s+authenticated\s+domains\s+matching\s+domain\s+"` + domain + `"\s+were\s+found`),
+			},
+			// No authenticated domain matches the subdomain
+			{
+				Config: testAccSenderAuthenticationDataSourceConfigDomain(domain, `
+data "sendgrid_sender_authentication" "test" {
+	domain    = sendgrid_sender_authentication.first.domain
+	subdomain = "em3"
+}
+`),
+				ExpectError: regexp.MustCompile(`no\s+authenticated\s+domain\s+matching\s+domain\s+"` + domain + `"\s+and\s+subdomain\s+"em3"\s+was\s+found`),
+			},
+		},
+	})
+}
+
+func TestAccSenderAuthenticationDataSource_idAndDomain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "sendgrid_sender_authentication" "test" {
+	id     = "1"
+	domain = "example.com"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+data "sendgrid_sender_authentication" "test" {
+	subdomain = "em1"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccSenderAuthenticationDataSourceConfigDomain(domain, dataSources string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_sender_authentication" "first" {
+	domain    = "%[1]s"
+	subdomain = "em1"
+	default   = true
+}
+
+resource "sendgrid_sender_authentication" "second" {
+	domain    = "%[1]s"
+	subdomain = "em2"
+
+	depends_on = [sendgrid_sender_authentication.first]
+}
+`, domain) + dataSources
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResourceWithConfigure = &senderAuthenticationListResource{}
+
+func newSenderAuthenticationListResource() list.ListResource {
+	return &senderAuthenticationListResource{}
+}
+
+type senderAuthenticationListResource struct {
+	senderAuthenticationResource
+}
+
+type senderAuthenticationListResourceModel struct {
+	Domain          types.String `tfsdk:"domain"`
+	Username        types.String `tfsdk:"username"`
+	ExcludeSubusers types.Bool   `tfsdk:"exclude_subusers"`
+}
+
+func (r *senderAuthenticationListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the authenticated domains of your SendGrid account. SendGrid does not return the custom DKIM selector and region of a domain, so they are set as on import.",
+		Attributes: map[string]listschema.Attribute{
+			"domain": listschema.StringAttribute{
+				MarkdownDescription: "Only list the authenticated domains of this domain.",
+				Optional:            true,
+			},
+			"username": listschema.StringAttribute{
+				MarkdownDescription: "Only list the authenticated domains associated with this subuser.",
+				Optional:            true,
+			},
+			"exclude_subusers": listschema.BoolAttribute{
+				MarkdownDescription: "Exclude the authenticated domains of subusers.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *senderAuthenticationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data senderAuthenticationListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := listAllAuthenticatedDomains(ctx, r.client, sendgrid.InputGetAuthenticatedDomains{
+		Domain:          data.Domain.ValueString(),
+		Username:        data.Username.ValueString(),
+		ExcludeSubusers: data.ExcludeSubusers.ValueBool(),
+	})
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing sender authentications",
+			fmt.Sprintf("Unable to list authenticated domains, got error: %s", err),
+		)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		push = limitResults(req, push)
+		for _, v := range items {
+			m, diags := senderAuthenticationImportModelFrom(ctx, &sendgrid.OutputGetAuthenticatedDomain{
+				ID:                v.ID,
+				UserID:            v.UserID,
+				Subdomain:         v.Subdomain,
+				Domain:            v.Domain,
+				Username:          v.Username,
+				IPs:               v.IPs,
+				CustomSpf:         v.CustomSpf,
+				Default:           v.Default,
+				Legacy:            v.Legacy,
+				AutomaticSecurity: v.AutomaticSecurity,
+				Valid:             v.Valid,
+				DNS:               v.DNS,
+			})
+			if diags.HasError() {
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+			if !push(newListResult(ctx, req, v.Domain, m.identity(), &m)) {
+				return
+			}
+		}
+	}
+}
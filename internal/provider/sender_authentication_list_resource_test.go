@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSenderAuthenticationListResource(t *testing.T) {
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSenderAuthenticationResourceConfig(domain),
+			},
+			// ImportState by identity testing
+			{
+				ResourceName:    "sendgrid_sender_authentication.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				Query:  true,
+				Config: testAccSenderAuthenticationListResourceQuery(domain),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("sendgrid_sender_authentication.test", 1),
+					querycheck.ExpectIdentity("sendgrid_sender_authentication.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					querycheck.ExpectResourceKnownValues("sendgrid_sender_authentication.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(domain)),
+						[]querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("domain"), KnownValue: knownvalue.StringExact(domain)},
+							{Path: tfjsonpath.New("automatic_security"), KnownValue: knownvalue.Bool(true)},
+							{Path: tfjsonpath.New("region"), KnownValue: knownvalue.StringExact("global")},
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccSenderAuthenticationListResourceQuery(domain string) string {
+	return fmt.Sprintf(`
+list "sendgrid_sender_authentication" "test" {
+	provider         = sendgrid
+	include_resource = true
+
+	config {
+		domain = "%s"
+	}
+}
+`, domain)
+}
@@ -6,11 +6,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -27,6 +30,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &senderAuthenticationResource{}
 var _ resource.ResourceWithImportState = &senderAuthenticationResource{}
+var _ resource.ResourceWithIdentity = &senderAuthenticationResource{}
+var _ resource.ResourceWithModifyPlan = &senderAuthenticationResource{}
 
 func newSenderAuthenticationResource() resource.Resource {
 	return &senderAuthenticationResource{}
@@ -50,6 +55,20 @@ type senderAuthenticationResourceModel struct {
 	Valid              types.Bool   `tfsdk:"valid"`
 	Region             types.String `tfsdk:"region"`
 	AutomaticSecurity  types.Bool   `tfsdk:"automatic_security"`
+
+	WaitForValidation      types.Bool   `tfsdk:"wait_for_validation"`
+	ValidationTimeout      types.String `tfsdk:"validation_timeout"`
+	ValidationPollInterval types.String `tfsdk:"validation_poll_interval"`
+}
+
+type senderAuthenticationIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (m senderAuthenticationResourceModel) identity() senderAuthenticationIdentityModel {
+	return senderAuthenticationIdentityModel{
+		ID: m.ID,
+	}
 }
 
 func (r *senderAuthenticationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -169,6 +188,18 @@ Note: The region attribute can only be specified during resource creation. When
 			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, validationAttributes("the domain", "sendgrid_sender_authentication_validation"))
+}
+
+func (r *senderAuthenticationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the authenticated domain.",
+				RequiredForImport: true,
+			},
+		},
+	}
 }
 
 func (r *senderAuthenticationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -190,6 +221,10 @@ func (r *senderAuthenticationResource) Configure(ctx context.Context, req resour
 	r.client = client
 }
 
+func (r *senderAuthenticationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planRevalidation(ctx, req, resp, path.Root("dns"))
+}
+
 func (r *senderAuthenticationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data senderAuthenticationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -225,9 +260,7 @@ func (r *senderAuthenticationResource) Create(ctx context.Context, req resource.
 		input.CustomDkimSelector = customDkimSelector
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.AuthenticateDomain(context.TODO(), input)
-	})
+	o, err := r.client.AuthenticateDomain(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating sender authentication",
@@ -236,15 +269,6 @@ func (r *senderAuthenticationResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputAuthenticateDomain)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating sender authentication",
-			"Failed to assert type *sendgrid.OutputAuthenticateDomain",
-		)
-		return
-	}
-
 	ipsSet, d := types.SetValueFrom(ctx, types.StringType, o.IPs)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -266,8 +290,20 @@ func (r *senderAuthenticationResource) Create(ctx context.Context, req resource.
 		AutomaticSecurity:  types.BoolValue(o.AutomaticSecurity),
 		IPs:                ipsSet,
 		Region:             data.Region,
+
+		WaitForValidation:      data.WaitForValidation,
+		ValidationTimeout:      data.ValidationTimeout,
+		ValidationPollInterval: data.ValidationPollInterval,
+	}
+
+	if data.WaitForValidation.ValueBool() && !o.Valid {
+		var validation diag.Diagnostics
+		r.waitForValidation(ctx, &data, &validation)
+		resp.Diagnostics.Append(createValidationDiagnostics(validation, "the domain", "sendgrid_sender_authentication_validation")...)
 	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -311,6 +347,10 @@ func (r *senderAuthenticationResource) Read(ctx context.Context, req resource.Re
 		DNS:                convertDNSToSetType(o.DNS),
 		AutomaticSecurity:  types.BoolValue(o.AutomaticSecurity),
 		Region:             data.Region,
+
+		WaitForValidation:      data.WaitForValidation,
+		ValidationTimeout:      data.ValidationTimeout,
+		ValidationPollInterval: data.ValidationPollInterval,
 	}
 
 	// The SendGrid API does not return the region in the GetAuthenticatedDomain response, so we set it to the default value during read
@@ -319,6 +359,7 @@ func (r *senderAuthenticationResource) Read(ctx context.Context, req resource.Re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -332,7 +373,7 @@ func (r *senderAuthenticationResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	id := data.ID.ValueString()
+	id := state.ID.ValueString()
 	domainId, _ := strconv.ParseInt(id, 10, 64)
 
 	o, err := r.client.UpdateDomainAuthentication(ctx, domainId, &sendgrid.InputUpdateDomainAuthentication{
@@ -366,6 +407,10 @@ func (r *senderAuthenticationResource) Update(ctx context.Context, req resource.
 		AutomaticSecurity:  types.BoolValue(o.AutomaticSecurity),
 		IPs:                ipsSet,
 		Region:             data.Region,
+
+		WaitForValidation:      data.WaitForValidation,
+		ValidationTimeout:      data.ValidationTimeout,
+		ValidationPollInterval: data.ValidationPollInterval,
 	}
 
 	// The SendGrid API does not return the region in the GetAuthenticatedDomain response, so we set it to the default value during read
@@ -373,7 +418,12 @@ func (r *senderAuthenticationResource) Update(ctx context.Context, req resource.
 		data.Region = types.StringValue("global")
 	}
 
+	if data.WaitForValidation.ValueBool() && !o.Valid {
+		r.waitForValidation(ctx, &data, &resp.Diagnostics)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -388,9 +438,7 @@ func (r *senderAuthenticationResource) Delete(ctx context.Context, req resource.
 
 	domainId := state.ID.ValueString()
 	id, _ := strconv.ParseInt(domainId, 10, 64)
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteAuthenticatedDomain(ctx, id)
-	})
+	err := r.client.DeleteAuthenticatedDomain(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting sender authentication",
@@ -401,11 +449,10 @@ func (r *senderAuthenticationResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *senderAuthenticationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var data senderAuthenticationResourceModel
-
-	domainId := req.ID
-
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	domainId := importIDOrIdentity(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	id, err := strconv.ParseInt(domainId, 10, 64)
 	if err != nil {
@@ -425,34 +472,81 @@ func (r *senderAuthenticationResource) ImportState(ctx context.Context, req reso
 		return
 	}
 
-	ipsSet, d := types.SetValueFrom(ctx, types.StringType, o.IPs)
+	data, d := senderAuthenticationImportModelFrom(ctx, o)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data = senderAuthenticationResourceModel{
-		ID:                 types.StringValue(strconv.FormatInt(o.ID, 10)),
-		UserID:             types.Int64Value(o.UserID),
-		Domain:             types.StringValue(o.Domain),
-		Subdomain:          types.StringValue(o.Subdomain),
-		Username:           types.StringValue(o.Username),
-		Default:            types.BoolValue(o.Default),
-		Legacy:             types.BoolValue(o.Legacy),
-		CustomDkimSelector: types.StringNull(), // The SendGrid API does not return the custom DKIM selector in the GetAuthenticatedDomain response, so we set it to null during import
-		Valid:              types.BoolValue(o.Valid),
-		DNS:                convertDNSToSetType(o.DNS),
-		AutomaticSecurity:  types.BoolValue(o.AutomaticSecurity),
-		IPs:                ipsSet,
-	}
-	// region is not returned in the GetAuthenticatedDomain response, so we set it to the default value during import
-	data.Region = types.StringValue("global")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// waitForValidation validates the domain until it is valid or the configured
+// timeout elapses, and refreshes valid and dns in data with the outcome.
+// Failing records are reported as an error, after which data is still worth
+// saving.
+func (r *senderAuthenticationResource) waitForValidation(ctx context.Context, data *senderAuthenticationResourceModel, diags *diag.Diagnostics) {
+	domainId, _ := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	timeout, interval := validationSettings(data.ValidationTimeout, data.ValidationPollInterval)
+
+	failures, err := waitForValidation(ctx, interval, timeout, func(ctx context.Context) (bool, []validationFailure, error) {
+		return validateAuthenticatedDomain(ctx, r.client, domainId)
+	})
+	if err != nil {
+		diags.AddError(
+			"Validating sender authentication",
+			fmt.Sprintf("Unable to validate authenticated domain (id: %d), got error: %s", domainId, err),
+		)
+		return
+	}
+
+	o, err := r.client.GetAuthenticatedDomain(ctx, domainId)
+	if err != nil {
+		diags.AddError(
+			"Validating sender authentication",
+			fmt.Sprintf("Unable to get authenticated domain (id: %d), got error: %s", domainId, err),
+		)
+		return
+	}
+	data.Valid = types.BoolValue(o.Valid)
+	data.DNS = convertDNSToSetType(o.DNS)
+
+	if len(failures) > 0 {
+		diags.AddError(
+			"Validating sender authentication",
+			fmt.Sprintf("The DNS records of domain %s (id: %d) were not valid after %s:\n%s", data.Domain.ValueString(), domainId, timeout, formatValidationFailures(failures)),
+		)
+	}
+}
+
+// validateAuthenticatedDomain asks SendGrid to validate the DNS records of
+// the authenticated domain once.
+func validateAuthenticatedDomain(ctx context.Context, client *sendgrid.Client, id int64) (bool, []validationFailure, error) {
+	o, err := client.ValidateDomainAuthentication(ctx, id)
+	if err != nil {
+		return false, nil, err
+	}
+	var failures []validationFailure
+	for _, result := range []struct {
+		record string
+		sendgrid.ValidationResult
+	}{
+		{"mail_cname", o.ValidationResults.MailCname},
+		{"dkim1", o.ValidationResults.Dkim1},
+		{"dkim2", o.ValidationResults.Dkim2},
+		{"spf", o.ValidationResults.SPF},
+	} {
+		if !result.Valid && result.Reason != "" {
+			failures = append(failures, validationFailure{record: result.record, reason: result.Reason})
+		}
+	}
+	return o.Valid, failures, nil
+}
+
 func convertDNSToSetType(dns sendgrid.DNS) (recordsSet basetypes.SetValue) {
 	var records []attr.Value
 
@@ -568,3 +662,33 @@ func convertDNSToSetType(dns sendgrid.DNS) (recordsSet basetypes.SetValue) {
 
 	return recordsSet
 }
+
+// senderAuthenticationImportModelFrom converts an authenticated domain read
+// from SendGrid to the resource model of an imported sender authentication.
+func senderAuthenticationImportModelFrom(ctx context.Context, o *sendgrid.OutputGetAuthenticatedDomain) (senderAuthenticationResourceModel, diag.Diagnostics) {
+	ipsSet, d := types.SetValueFrom(ctx, types.StringType, o.IPs)
+	if d.HasError() {
+		return senderAuthenticationResourceModel{}, d
+	}
+	data := senderAuthenticationResourceModel{
+		ID:                 types.StringValue(strconv.FormatInt(o.ID, 10)),
+		UserID:             types.Int64Value(o.UserID),
+		Domain:             types.StringValue(o.Domain),
+		Subdomain:          types.StringValue(o.Subdomain),
+		Username:           types.StringValue(o.Username),
+		Default:            types.BoolValue(o.Default),
+		Legacy:             types.BoolValue(o.Legacy),
+		CustomDkimSelector: types.StringNull(), // The SendGrid API does not return the custom DKIM selector in the GetAuthenticatedDomain response, so we set it to null during import
+		Valid:              types.BoolValue(o.Valid),
+		DNS:                convertDNSToSetType(o.DNS),
+		AutomaticSecurity:  types.BoolValue(o.AutomaticSecurity),
+		IPs:                ipsSet,
+	}
+	// region is not returned in the GetAuthenticatedDomain response, so we set it to the default value during import
+	data.Region = types.StringValue("global")
+	data.WaitForValidation = types.BoolValue(false)
+	data.ValidationTimeout = types.StringValue(defaultValidationTimeout)
+	data.ValidationPollInterval = types.StringValue(defaultValidationPollInterval)
+
+	return data, d
+}
@@ -5,10 +5,12 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccSenderAuthenticationResource(t *testing.T) {
@@ -61,3 +63,105 @@ resource "sendgrid_sender_authentication" "test" {
 }
 `, domain)
 }
+
+func TestAccSenderAuthenticationResource_waitForValidation(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_sender_authentication.test"
+
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create without waiting
+			{
+				Config: testAccSenderAuthenticationResourceConfigWaitForValidation(domain, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_validation", "false"),
+				),
+			},
+			// Waiting times out while the DNS records are missing
+			{
+				Config:      testAccSenderAuthenticationResourceConfigWaitForValidation(domain, true),
+				ExpectError: regexp.MustCompile(`(?s)valid after 1s:.*mail_cname.*dkim1.*dkim2`),
+			},
+			// Validation is retried once the DNS records are published
+			{
+				PreConfig: func() {
+					server.PublishDNSRecord("mail." + domain)
+					server.PublishDNSRecord("s1._domainkey." + domain)
+					server.PublishDNSRecord("s2._domainkey." + domain)
+				},
+				Config: testAccSenderAuthenticationResourceConfigWaitForValidation(domain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_validation", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_validation", "validation_timeout", "validation_poll_interval"},
+			},
+		},
+	})
+}
+
+func TestAccSenderAuthenticationResource_waitForValidationOnCreate(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_sender_authentication.test"
+
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Waiting times out on create, which only warns, so the domain is
+			// saved as not valid and the next plan validates it again
+			{
+				Config: testAccSenderAuthenticationResourceConfigWaitForValidation(domain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// The domain is not replaced, but validated again
+			{
+				PreConfig: func() {
+					server.PublishDNSRecord("mail." + domain)
+					server.PublishDNSRecord("s1._domainkey." + domain)
+					server.PublishDNSRecord("s2._domainkey." + domain)
+				},
+				Config: testAccSenderAuthenticationResourceConfigWaitForValidation(domain, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSenderAuthenticationResourceConfigWaitForValidation(domain string, wait bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_sender_authentication" "test" {
+  domain                   = "%[1]s"
+  subdomain                = "mail"
+  wait_for_validation      = %[2]t
+  validation_timeout       = "1s"
+  validation_poll_interval = "100ms"
+}
+`, domain, wait)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &senderAuthenticationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &senderAuthenticationsDataSource{}
+)
+
+func newSenderAuthenticationsDataSource() datasource.DataSource {
+	return &senderAuthenticationsDataSource{}
+}
+
+type senderAuthenticationsDataSource struct {
+	client *sendgrid.Client
+}
+
+type senderAuthenticationsDataSourceModel struct {
+	Domain                types.String                 `tfsdk:"domain"`
+	Username              types.String                 `tfsdk:"username"`
+	ExcludeSubusers       types.Bool                   `tfsdk:"exclude_subusers"`
+	SenderAuthentications []senderAuthenticationsModel `tfsdk:"sender_authentications"`
+}
+
+type senderAuthenticationsModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Domain            types.String   `tfsdk:"domain"`
+	Subdomain         types.String   `tfsdk:"subdomain"`
+	Username          types.String   `tfsdk:"username"`
+	IPs               []types.String `tfsdk:"ips"`
+	CustomSPF         types.Bool     `tfsdk:"custom_spf"`
+	Default           types.Bool     `tfsdk:"default"`
+	Legacy            types.Bool     `tfsdk:"legacy"`
+	AutomaticSecurity types.Bool     `tfsdk:"automatic_security"`
+	Valid             types.Bool     `tfsdk:"valid"`
+}
+
+func (d *senderAuthenticationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sender_authentications"
+}
+
+func (d *senderAuthenticationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *senderAuthenticationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the authenticated domains of your SendGrid account, including the domains of your subusers unless ` + "`exclude_subusers`" + ` is set.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Only list the authenticated domains of this domain.",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Only list the authenticated domains associated with this subuser.",
+				Optional:            true,
+			},
+			"exclude_subusers": schema.BoolAttribute{
+				MarkdownDescription: "Exclude the authenticated domains of subusers.",
+				Optional:            true,
+			},
+			"sender_authentications": schema.ListNestedAttribute{
+				MarkdownDescription: "The authenticated domains matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the authenticated domain.",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The authenticated domain.",
+							Computed:            true,
+						},
+						"subdomain": schema.StringAttribute{
+							MarkdownDescription: "The subdomain used for this authenticated domain.",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The username associated with this domain.",
+							Computed:            true,
+						},
+						"ips": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "The IP addresses included in the custom SPF record for this authenticated domain.",
+							Computed:            true,
+						},
+						"custom_spf": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if this authenticated domain uses a custom SPF record.",
+							Computed:            true,
+						},
+						"default": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if this authenticated domain is the fallback if no authenticated domains match the sender's domain.",
+							Computed:            true,
+						},
+						"legacy": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if this authenticated domain was created with the legacy authentication process.",
+							Computed:            true,
+						},
+						"automatic_security": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if SendGrid manages the SPF and DKIM records of this authenticated domain.",
+							Computed:            true,
+						},
+						"valid": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if this is a valid authenticated domain.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *senderAuthenticationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data senderAuthenticationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listAllAuthenticatedDomains(ctx, d.client, sendgrid.InputGetAuthenticatedDomains{
+		Domain:          data.Domain.ValueString(),
+		Username:        data.Username.ValueString(),
+		ExcludeSubusers: data.ExcludeSubusers.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading sender authentications",
+			fmt.Sprintf("Unable to list authenticated domains, got error: %s", err),
+		)
+		return
+	}
+
+	data.SenderAuthentications = []senderAuthenticationsModel{}
+	for _, v := range items {
+		ips := []types.String{}
+		for _, ip := range v.IPs {
+			ips = append(ips, types.StringValue(ip))
+		}
+		data.SenderAuthentications = append(data.SenderAuthentications, senderAuthenticationsModel{
+			ID:                types.StringValue(strconv.FormatInt(v.ID, 10)),
+			Domain:            types.StringValue(v.Domain),
+			Subdomain:         types.StringValue(v.Subdomain),
+			Username:          types.StringValue(v.Username),
+			IPs:               ips,
+			CustomSPF:         types.BoolValue(v.CustomSpf),
+			Default:           types.BoolValue(v.Default),
+			Legacy:            types.BoolValue(v.Legacy),
+			AutomaticSecurity: types.BoolValue(v.AutomaticSecurity),
+			Valid:             types.BoolValue(v.Valid),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
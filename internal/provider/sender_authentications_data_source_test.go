@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSenderAuthenticationsDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_sender_authentications.test"
+
+	domain := fmt.Sprintf("test-acc-%s.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSenderAuthenticationsDataSourceConfig(domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sender_authentications.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "sender_authentications.0.id", "sendgrid_sender_authentication.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "sender_authentications.0.domain", domain),
+					resource.TestCheckResourceAttr(resourceName, "sender_authentications.0.valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSenderAuthenticationsDataSourceConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_sender_authentication" "test" {
+	domain = "%[1]s"
+}
+
+data "sendgrid_sender_authentications" "test" {
+	domain           = sendgrid_sender_authentication.test.domain
+	exclude_subusers = true
+}
+`, domain)
+}
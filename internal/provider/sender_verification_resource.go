@@ -8,8 +8,8 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +20,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &senderVerificationResource{}
 var _ resource.ResourceWithImportState = &senderVerificationResource{}
+var _ resource.ResourceWithIdentity = &senderVerificationResource{}
 
 func newSenderVerificationResource() resource.Resource {
 	return &senderVerificationResource{}
@@ -44,6 +45,19 @@ type senderVerificationResourceModel struct {
 	Country     types.String `tfsdk:"country"`
 	Verified    types.Bool   `tfsdk:"verified"`
 	Locked      types.Bool   `tfsdk:"locked"`
+	OnBehalfOf  types.String `tfsdk:"on_behalf_of"`
+}
+
+type senderVerificationIdentityModel struct {
+	ID         types.String `tfsdk:"id"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (m senderVerificationResourceModel) identity() senderVerificationIdentityModel {
+	return senderVerificationIdentityModel{
+		ID:         m.ID,
+		OnBehalfOf: m.OnBehalfOf,
+	}
 }
 
 func (r *senderVerificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -123,6 +137,19 @@ For more detailed information, please see the [SendGrid documentation](https://d
 				MarkdownDescription: "locked",
 				Computed:            true,
 			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *senderVerificationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the verified sender.",
+				RequiredForImport: true,
+			},
+			"on_behalf_of": onBehalfOfIdentityAttribute(),
 		},
 	}
 }
@@ -153,20 +180,20 @@ func (r *senderVerificationResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateVerifiedSenderRequest(context.TODO(), &sendgrid.InputCreateVerifiedSenderRequest{
-			Nickname:    data.Nickname.ValueString(),
-			FromEmail:   data.FromEmail.ValueString(),
-			FromName:    data.FromName.ValueString(),
-			ReplyTo:     data.ReplyTo.ValueString(),
-			ReplyToName: data.ReplyToName.ValueString(),
-			Address:     data.Address.ValueString(),
-			Address2:    data.Address2.ValueString(),
-			State:       data.State.ValueString(),
-			City:        data.City.ValueString(),
-			Zip:         data.Zip.ValueString(),
-			Country:     data.Country.ValueString(),
-		})
+	ctx = withOnBehalfOf(ctx, data.OnBehalfOf.ValueString())
+
+	o, err := r.client.CreateVerifiedSenderRequest(ctx, &sendgrid.InputCreateVerifiedSenderRequest{
+		Nickname:    data.Nickname.ValueString(),
+		FromEmail:   data.FromEmail.ValueString(),
+		FromName:    data.FromName.ValueString(),
+		ReplyTo:     data.ReplyTo.ValueString(),
+		ReplyToName: data.ReplyToName.ValueString(),
+		Address:     data.Address.ValueString(),
+		Address2:    data.Address2.ValueString(),
+		State:       data.State.ValueString(),
+		City:        data.City.ValueString(),
+		Zip:         data.Zip.ValueString(),
+		Country:     data.Country.ValueString(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -176,15 +203,6 @@ func (r *senderVerificationResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateVerifiedSenderRequest)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating sender verification",
-			"Failed to assert type *sendgrid.OutputCreateVerifiedSenderRequest",
-		)
-		return
-	}
-
 	id := strconv.FormatInt(o.ID, 10)
 	data.ID = types.StringValue(id)
 	data.Nickname = types.StringValue(o.Nickname)
@@ -202,6 +220,7 @@ func (r *senderVerificationResource) Create(ctx context.Context, req resource.Cr
 	data.Locked = types.BoolValue(o.Locked)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -214,6 +233,8 @@ func (r *senderVerificationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, data.OnBehalfOf.ValueString())
+
 	id := data.ID.ValueString()
 	verifiedSenderId, _ := strconv.ParseInt(id, 10, 64)
 	senders, err := r.client.GetVerifiedSenders(ctx, &sendgrid.InputGetVerifiedSenders{
@@ -253,6 +274,7 @@ func (r *senderVerificationResource) Read(ctx context.Context, req resource.Read
 	data.Locked = types.BoolValue(o.Locked)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -266,6 +288,8 @@ func (r *senderVerificationResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	id := state.ID.ValueString()
 	verifiedSenderId, _ := strconv.ParseInt(id, 10, 64)
 
@@ -329,6 +353,7 @@ func (r *senderVerificationResource) Update(ctx context.Context, req resource.Up
 	data.Locked = types.BoolValue(o.Locked)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -341,11 +366,11 @@ func (r *senderVerificationResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, data.OnBehalfOf.ValueString())
+
 	verifiedSenderId := data.ID.ValueString()
 	id, _ := strconv.ParseInt(verifiedSenderId, 10, 64)
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteVerifiedSender(ctx, id)
-	})
+	err := r.client.DeleteVerifiedSender(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting sender verification",
@@ -358,8 +383,11 @@ func (r *senderVerificationResource) Delete(ctx context.Context, req resource.De
 func (r *senderVerificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data senderVerificationResourceModel
 
-	id := req.ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	onBehalfOf, id := importOnBehalfOfID(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withOnBehalfOf(ctx, onBehalfOf)
 
 	verifiedSenderId, _ := strconv.ParseInt(id, 10, 64)
 	senders, err := r.client.GetVerifiedSenders(ctx, &sendgrid.InputGetVerifiedSenders{
@@ -397,8 +425,12 @@ func (r *senderVerificationResource) ImportState(ctx context.Context, req resour
 	data.ReplyToName = types.StringValue(o.ReplyToName)
 	data.Verified = types.BoolValue(o.Verified)
 	data.Locked = types.BoolValue(o.Locked)
+	if onBehalfOf != "" {
+		data.OnBehalfOf = types.StringValue(onBehalfOf)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &spamReportsDataSource{}
+	_ datasource.DataSourceWithConfigure = &spamReportsDataSource{}
+)
+
+func newSpamReportsDataSource() datasource.DataSource {
+	return &spamReportsDataSource{}
+}
+
+type spamReportsDataSource struct {
+	client *sendgrid.Client
+}
+
+type spamReportsDataSourceModel struct {
+	StartTime   types.Int64        `tfsdk:"start_time"`
+	EndTime     types.Int64        `tfsdk:"end_time"`
+	Email       types.String       `tfsdk:"email"`
+	SpamReports []spamReportsModel `tfsdk:"spam_reports"`
+}
+
+type spamReportsModel struct {
+	Email   types.String `tfsdk:"email"`
+	Created types.Int64  `tfsdk:"created"`
+	IP      types.String `tfsdk:"ip"`
+}
+
+func (d *spamReportsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spam_reports"
+}
+
+func (d *spamReportsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *spamReportsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the spam reports of your SendGrid account.
+
+Spam reports are addresses of recipients who marked your emails as spam. SendGrid suppresses them until they are removed from the list.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"spam_reports": schema.ListNestedAttribute{
+				MarkdownDescription: "The spam reports matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the spam report.",
+							Computed:            true,
+						},
+						"created": schema.Int64Attribute{
+							MarkdownDescription: "The Unix timestamp of when the spam report was created.",
+							Computed:            true,
+						},
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "The IP address the reported email was sent from.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, suppressionListFilterAttributes("spam reports"))
+}
+
+func (d *spamReportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data spamReportsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listAllSuppressions(ctx, suppressionListOptions(data.StartTime, data.EndTime, data.Email), d.client.GetSpamReports)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading spam reports",
+			fmt.Sprintf("Unable to list spam reports, got error: %s", err),
+		)
+		return
+	}
+
+	data.SpamReports = make([]spamReportsModel, 0, len(items))
+	for _, v := range items {
+		data.SpamReports = append(data.SpamReports, spamReportsModel{
+			Email:   types.StringValue(v.Email),
+			Created: types.Int64Value(v.Created),
+			IP:      types.StringValue(v.IP),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/kenzo0107/sendgrid"
+)
+
+func TestAccSpamReportsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "sendgrid_spam_reports" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sendgrid_spam_reports.test", "spam_reports.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSpamReportsDataSource_filters(t *testing.T) {
+	server := testAccOffline(t)
+
+	server.AddSpamReport(sendgrid.SpamReport{Email: "old-spam_reports@example.com", Created: 1700000000, IP: "192.0.2.10"})
+	server.AddSpamReport(sendgrid.SpamReport{Email: "new-spam_reports@example.com", Created: 1800000000, IP: "192.0.2.10"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSpamReportsDataSourceFiltersConfig(1750000000, 1850000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_spam_reports.test", "spam_reports.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_spam_reports.test", "spam_reports.0.email", "new-spam_reports@example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_spam_reports.test", "spam_reports.0.created", "1800000000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpamReportsDataSourceFiltersConfig(startTime, endTime int64) string {
+	return fmt.Sprintf(`
+data "sendgrid_spam_reports" "test" {
+  start_time = %d
+  end_time   = %d
+}
+`, startTime, endTime)
+}
@@ -8,8 +8,8 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
@@ -18,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ssoCertificateResource{}
 var _ resource.ResourceWithImportState = &ssoCertificateResource{}
+var _ resource.ResourceWithIdentity = &ssoCertificateResource{}
 
 func newSSOCertificateResource() resource.Resource {
 	return &ssoCertificateResource{}
@@ -35,6 +36,16 @@ type ssoCertificateResourceModel struct {
 	NotAfter          types.Int64  `tfsdk:"not_after"`
 }
 
+type ssoCertificateIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (m ssoCertificateResourceModel) identity() ssoCertificateIdentityModel {
+	return ssoCertificateIdentityModel{
+		ID: m.ID,
+	}
+}
+
 func (r *ssoCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sso_certificate"
 }
@@ -69,6 +80,17 @@ Provides SSO Certificate resource.
 	}
 }
 
+func (r *ssoCertificateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the SSO certificate.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ssoCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -101,9 +123,7 @@ func (r *ssoCertificateResource) Create(ctx context.Context, req resource.Create
 		Enabled:           true,
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateSSOCertificate(ctx, input)
-	})
+	o, err := r.client.CreateSSOCertificate(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating sso certificate",
@@ -112,15 +132,6 @@ func (r *ssoCertificateResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateSSOCertificate)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating sso certificate",
-			"Failed to assert type *sendgrid.OutputCreateSSOCertificate",
-		)
-		return
-	}
-
 	plan = ssoCertificateResourceModel{
 		ID:                types.StringValue(strconv.FormatInt(o.ID, 10)),
 		PublicCertificate: types.StringValue(o.PublicCertificate),
@@ -129,6 +140,7 @@ func (r *ssoCertificateResource) Create(ctx context.Context, req resource.Create
 		NotAfter:          types.Int64Value(o.NotAfter),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -161,6 +173,7 @@ func (r *ssoCertificateResource) Read(ctx context.Context, req resource.ReadRequ
 		NotAfter:          types.Int64Value(o.NotAfter),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -203,6 +216,7 @@ func (r *ssoCertificateResource) Update(ctx context.Context, req resource.Update
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -217,9 +231,7 @@ func (r *ssoCertificateResource) Delete(ctx context.Context, req resource.Delete
 
 	certificateId := state.ID.ValueString()
 	id, _ := strconv.ParseInt(certificateId, 10, 64)
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteSSOCertificate(ctx, id)
-	})
+	err := r.client.DeleteSSOCertificate(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting sso certificate",
@@ -232,8 +244,10 @@ func (r *ssoCertificateResource) Delete(ctx context.Context, req resource.Delete
 func (r *ssoCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data ssoCertificateResourceModel
 
-	certificateId := req.ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	certificateId := importIDOrIdentity(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	id, _ := strconv.ParseInt(certificateId, 10, 64)
 	o, err := r.client.GetSSOCertificate(ctx, id)
@@ -253,6 +267,7 @@ func (r *ssoCertificateResource) ImportState(ctx context.Context, req resource.I
 		NotAfter:          types.Int64Value(o.NotAfter),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
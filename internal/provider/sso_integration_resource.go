@@ -7,8 +7,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
@@ -17,6 +17,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ssoIntegrationResource{}
 var _ resource.ResourceWithImportState = &ssoIntegrationResource{}
+var _ resource.ResourceWithIdentity = &ssoIntegrationResource{}
 
 func newSSOIntegrationResource() resource.Resource {
 	return &ssoIntegrationResource{}
@@ -38,6 +39,16 @@ type ssoIntegrationResourceModel struct {
 	AudienceURL          types.String `tfsdk:"audience_url"`
 }
 
+type ssoIntegrationIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (m ssoIntegrationResourceModel) identity() ssoIntegrationIdentityModel {
+	return ssoIntegrationIdentityModel{
+		ID: m.ID,
+	}
+}
+
 func (r *ssoIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sso_integration"
 }
@@ -88,6 +99,17 @@ Provides SSO Integration resource.
 	}
 }
 
+func (r *ssoIntegrationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the SSO integration.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ssoIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -126,9 +148,7 @@ func (r *ssoIntegrationResource) Create(ctx context.Context, req resource.Create
 		input.CompletedIntegration = plan.CompletedIntegration.ValueBool()
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateSSOIntegration(ctx, input)
-	})
+	o, err := r.client.CreateSSOIntegration(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating SSO Integration",
@@ -137,15 +157,6 @@ func (r *ssoIntegrationResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateSSOIntegration)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating SSO Integration",
-			"Failed to assert type *sendgrid.OutputCreateSSOIntegration",
-		)
-		return
-	}
-
 	plan = ssoIntegrationResourceModel{
 		ID:                   types.StringValue(o.ID),
 		Name:                 types.StringValue(o.Name),
@@ -158,6 +169,7 @@ func (r *ssoIntegrationResource) Create(ctx context.Context, req resource.Create
 		AudienceURL:          types.StringValue(o.AudienceURL),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -193,6 +205,7 @@ func (r *ssoIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 		AudienceURL:          types.StringValue(o.AudienceURL),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -249,6 +262,7 @@ func (r *ssoIntegrationResource) Update(ctx context.Context, req resource.Update
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -263,9 +277,7 @@ func (r *ssoIntegrationResource) Delete(ctx context.Context, req resource.Delete
 
 	id := state.ID.ValueString()
 
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteSSOIntegration(ctx, id)
-	})
+	err := r.client.DeleteSSOIntegration(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting sso integration",
@@ -278,8 +290,10 @@ func (r *ssoIntegrationResource) Delete(ctx context.Context, req resource.Delete
 func (r *ssoIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data ssoIntegrationResourceModel
 
-	id := req.ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importIDOrIdentity(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	o, err := r.client.GetSSOIntegration(ctx, id)
 	if err != nil {
@@ -302,6 +316,7 @@ func (r *ssoIntegrationResource) ImportState(ctx context.Context, req resource.I
 		AudienceURL:          types.StringValue(o.AudienceURL),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -36,6 +37,7 @@ const subuserAccessInvalidScopeReason = "This scope is assigned automatically at
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ssoTeammateResource{}
 var _ resource.ResourceWithImportState = &ssoTeammateResource{}
+var _ resource.ResourceWithIdentity = &ssoTeammateResource{}
 
 func newSSOTeammateResource() resource.Resource {
 	return &ssoTeammateResource{}
@@ -187,6 +189,16 @@ type ssoTeammateResourceModel struct {
 	SubuserAccess []ssoSubuserAccessResourceModel `tfsdk:"subuser_access"`
 }
 
+type ssoTeammateIdentityModel struct {
+	Email types.String `tfsdk:"email"`
+}
+
+func (m ssoTeammateResourceModel) identity() ssoTeammateIdentityModel {
+	return ssoTeammateIdentityModel{
+		Email: m.Email,
+	}
+}
+
 func (r *ssoTeammateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sso_teammate"
 }
@@ -229,7 +241,7 @@ For more detailed information, please see the [SendGrid documentation](https://d
 			},
 			"scopes": schema.SetAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "Add or remove permissions from a Teammate using this scopes property. See [Teammate Permissions](https://www.twilio.com/docs/sendgrid/ui/account-and-settings/teammate-permissions) for a complete list of available scopes. You should not include this propety in the request when setting the `is_admin` property to `true` or `subuser_access` property to a list of subuser accesses. The following Scopes are set automatically by SendGrid, so they cannot be set manually:" + flex.QuoteAndJoin(autoScopes),
+				MarkdownDescription: "Add or remove permissions from a Teammate using this scopes property. See [Teammate Permissions](https://www.twilio.com/docs/sendgrid/ui/account-and-settings/teammate-permissions) for a complete list of available scopes, or the `sendgrid_scope_preset` data source to start from a persona. You should not include this propety in the request when setting the `is_admin` property to `true` or `subuser_access` property to a list of subuser accesses. The following Scopes are set automatically by SendGrid, so they cannot be set manually:" + flex.QuoteAndJoin(autoScopes),
 				Optional:            true,
 				Validators: []validator.Set{
 					setvalidator.ConflictsWith(
@@ -285,6 +297,17 @@ For more detailed information, please see the [SendGrid documentation](https://d
 	}
 }
 
+func (r *ssoTeammateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"email": identityschema.StringAttribute{
+				Description:       "The email address of the SSO teammate.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ssoTeammateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -348,10 +371,7 @@ func (r *ssoTeammateResource) Create(ctx context.Context, req resource.CreateReq
 		Scopes:                     scopes,
 	}
 
-	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateSSOTeammate(context.TODO(), input)
-	})
+	o, err := r.client.CreateSSOTeammate(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating SSO teammate",
@@ -360,12 +380,6 @@ func (r *ssoTeammateResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateSSOTeammate)
-	if !ok {
-		resp.Diagnostics.AddError("Creating sso teammate", "Failed to assert type *sendgrid.OutputCreateSSOTeammate")
-		return
-	}
-
 	// NOTE: The creation API answers 201 with subuser_access omitted when it discarded the value, so
 	//       an empty response here means the teammate was created without the requested access.
 	//       Report that instead of recording the resource as if the request had been applied.
@@ -421,6 +435,7 @@ func (r *ssoTeammateResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -496,6 +511,7 @@ func (r *ssoTeammateResource) Read(ctx context.Context, req resource.ReadRequest
 		SubuserAccess: saArray,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -585,6 +601,7 @@ func (r *ssoTeammateResource) Update(ctx context.Context, req resource.UpdateReq
 		SubuserAccess: saArray,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -599,9 +616,7 @@ func (r *ssoTeammateResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	email := data.Email.ValueString()
 
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteTeammate(ctx, email)
-	})
+	err := r.client.DeleteTeammate(ctx, email)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting SSO teammate",
@@ -618,9 +633,10 @@ func (r *ssoTeammateResource) Delete(ctx context.Context, req resource.DeleteReq
 func (r *ssoTeammateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data ssoTeammateResourceModel
 
-	email := req.ID
-
-	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
+	email := importIDOrIdentity(ctx, req, resp, "email")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	teammate, err := r.client.GetTeammate(ctx, email)
 	if err != nil {
@@ -678,6 +694,7 @@ func (r *ssoTeammateResource) ImportState(ctx context.Context, req resource.Impo
 		SubuserAccess: saArray,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
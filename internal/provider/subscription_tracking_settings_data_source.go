@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &subscriptionTrackingSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &subscriptionTrackingSettingsDataSource{}
+)
+
+func newSubscriptionTrackingSettingsDataSource() datasource.DataSource {
+	return &subscriptionTrackingSettingsDataSource{}
+}
+
+type subscriptionTrackingSettingsDataSource struct {
+	client *sendgrid.Client
+}
+
+type subscriptionTrackingSettingsDataSourceModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	HTMLContent  types.String `tfsdk:"html_content"`
+	PlainContent types.String `tfsdk:"plain_content"`
+	Landing      types.String `tfsdk:"landing"`
+	Replace      types.String `tfsdk:"replace"`
+	URL          types.String `tfsdk:"url"`
+}
+
+func (d *subscriptionTrackingSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_tracking_settings"
+}
+
+func (d *subscriptionTrackingSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *subscriptionTrackingSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Subscription Tracking adds a link at the bottom of your emails that recipients can use to unsubscribe from them.
+
+The ` + "`unsubscribe`" + `, ` + "`group_unsubscribe`" + ` and ` + "`group_resubscribe`" + ` events of ` + "`sendgrid_event_webhook`" + ` are only sent when subscription tracking is enabled.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if subscription tracking is enabled.",
+				Computed:            true,
+			},
+			"html_content": schema.StringAttribute{
+				MarkdownDescription: "The HTML content of the unsubscribe link footer. It must contain the `<% %>` tag, which is replaced with the unsubscribe link.",
+				Computed:            true,
+			},
+			"plain_content": schema.StringAttribute{
+				MarkdownDescription: "The plain text content of the unsubscribe link footer. It must contain the `<% %>` tag, which is replaced with the unsubscribe link.",
+				Computed:            true,
+			},
+			"landing": schema.StringAttribute{
+				MarkdownDescription: "The HTML content of the landing page recipients see after they unsubscribe.",
+				Computed:            true,
+			},
+			"replace": schema.StringAttribute{
+				MarkdownDescription: "A tag that, when found in your emails, is replaced with the unsubscribe link instead of adding the footer.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of a custom landing page to redirect recipients to after they unsubscribe, instead of the default one.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *subscriptionTrackingSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data subscriptionTrackingSettingsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := d.client.GetSubscriptionTrackingSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading subscription tracking settings",
+			fmt.Sprintf("Unable to get subscription tracking settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = subscriptionTrackingSettingsDataSourceModel{
+		Enabled:      types.BoolValue(o.Enabled),
+		HTMLContent:  types.StringValue(o.HTMLContent),
+		PlainContent: types.StringValue(o.PlainContent),
+		Landing:      types.StringValue(o.Landing),
+		Replace:      types.StringValue(o.Replace),
+		URL:          types.StringValue(o.URL),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
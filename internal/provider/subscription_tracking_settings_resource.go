@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &subscriptionTrackingSettingsResource{}
+var _ resource.ResourceWithImportState = &subscriptionTrackingSettingsResource{}
+
+func newSubscriptionTrackingSettingsResource() resource.Resource {
+	return &subscriptionTrackingSettingsResource{}
+}
+
+type subscriptionTrackingSettingsResource struct {
+	client *sendgrid.Client
+}
+
+type subscriptionTrackingSettingsResourceModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	HTMLContent  types.String `tfsdk:"html_content"`
+	PlainContent types.String `tfsdk:"plain_content"`
+	Landing      types.String `tfsdk:"landing"`
+	Replace      types.String `tfsdk:"replace"`
+	URL          types.String `tfsdk:"url"`
+}
+
+func (r *subscriptionTrackingSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_tracking_settings"
+}
+
+func (r *subscriptionTrackingSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Subscription Tracking adds a link at the bottom of your emails that recipients can use to unsubscribe from them.
+
+The ` + "`unsubscribe`" + `, ` + "`group_unsubscribe`" + ` and ` + "`group_resubscribe`" + ` events of ` + "`sendgrid_event_webhook`" + ` are only sent when subscription tracking is enabled.
+
+Attributes that are not configured keep their current value, and cannot be cleared once set. Destroying this resource disables the setting and leaves the other attributes unchanged.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if subscription tracking is enabled.",
+				Required:            true,
+			},
+			"html_content": schema.StringAttribute{
+				MarkdownDescription: "The HTML content of the unsubscribe link footer. It must contain the `<% %>` tag, which is replaced with the unsubscribe link.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"plain_content": schema.StringAttribute{
+				MarkdownDescription: "The plain text content of the unsubscribe link footer. It must contain the `<% %>` tag, which is replaced with the unsubscribe link.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"landing": schema.StringAttribute{
+				MarkdownDescription: "The HTML content of the landing page recipients see after they unsubscribe.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"replace": schema.StringAttribute{
+				MarkdownDescription: "A tag that, when found in your emails, is replaced with the unsubscribe link instead of adding the footer.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of a custom landing page to redirect recipients to after they unsubscribe, instead of the default one.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *subscriptionTrackingSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *subscriptionTrackingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subscriptionTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.UpdateSubscriptionTrackingSettings(ctx, &sendgrid.InputUpdateSubscriptionTrackingSettings{
+		Enabled:      plan.Enabled.ValueBool(),
+		HTMLContent:  plan.HTMLContent.ValueString(),
+		PlainContent: plan.PlainContent.ValueString(),
+		Landing:      plan.Landing.ValueString(),
+		Replace:      plan.Replace.ValueString(),
+		URL:          plan.URL.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating subscription tracking settings",
+			fmt.Sprintf("Unable to update subscription tracking settings, got error: %s", err),
+		)
+		return
+	}
+
+	plan = subscriptionTrackingSettingsResourceModel{
+		Enabled:      types.BoolValue(o.Enabled),
+		HTMLContent:  types.StringValue(o.HTMLContent),
+		PlainContent: types.StringValue(o.PlainContent),
+		Landing:      types.StringValue(o.Landing),
+		Replace:      types.StringValue(o.Replace),
+		URL:          types.StringValue(o.URL),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subscriptionTrackingSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subscriptionTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.GetSubscriptionTrackingSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading subscription tracking settings",
+			fmt.Sprintf("Unable to read subscription tracking settings, got error: %s", err),
+		)
+		return
+	}
+
+	state = subscriptionTrackingSettingsResourceModel{
+		Enabled:      types.BoolValue(o.Enabled),
+		HTMLContent:  types.StringValue(o.HTMLContent),
+		PlainContent: types.StringValue(o.PlainContent),
+		Landing:      types.StringValue(o.Landing),
+		Replace:      types.StringValue(o.Replace),
+		URL:          types.StringValue(o.URL),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subscriptionTrackingSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data subscriptionTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.UpdateSubscriptionTrackingSettings(ctx, &sendgrid.InputUpdateSubscriptionTrackingSettings{
+		Enabled:      data.Enabled.ValueBool(),
+		HTMLContent:  data.HTMLContent.ValueString(),
+		PlainContent: data.PlainContent.ValueString(),
+		Landing:      data.Landing.ValueString(),
+		Replace:      data.Replace.ValueString(),
+		URL:          data.URL.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating subscription tracking settings",
+			fmt.Sprintf("Unable to update subscription tracking settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = subscriptionTrackingSettingsResourceModel{
+		Enabled:      types.BoolValue(o.Enabled),
+		HTMLContent:  types.StringValue(o.HTMLContent),
+		PlainContent: types.StringValue(o.PlainContent),
+		Landing:      types.StringValue(o.Landing),
+		Replace:      types.StringValue(o.Replace),
+		URL:          types.StringValue(o.URL),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subscriptionTrackingSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subscriptionTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tracking settings cannot be deleted, so disable the setting instead.
+	_, err := r.client.UpdateSubscriptionTrackingSettings(ctx, &sendgrid.InputUpdateSubscriptionTrackingSettings{
+		Enabled: false,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting subscription tracking settings",
+			fmt.Sprintf("Unable to disable subscription tracking settings, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *subscriptionTrackingSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data subscriptionTrackingSettingsResourceModel
+
+	o, err := r.client.GetSubscriptionTrackingSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing subscription tracking settings",
+			fmt.Sprintf("Unable to read subscription tracking settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = subscriptionTrackingSettingsResourceModel{
+		Enabled:      types.BoolValue(o.Enabled),
+		HTMLContent:  types.StringValue(o.HTMLContent),
+		PlainContent: types.StringValue(o.PlainContent),
+		Landing:      types.StringValue(o.Landing),
+		Replace:      types.StringValue(o.Replace),
+		URL:          types.StringValue(o.URL),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
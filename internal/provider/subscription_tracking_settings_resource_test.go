@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubscriptionTrackingSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_subscription_tracking_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSubscriptionTrackingSettingsResourceConfig(true, "<p>Unsubscribe <% here %></p>", "Unsubscribe: <% %>", "<p>You have been unsubscribed.</p>", "[unsubscribe]", "https://example.com/unsubscribed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "html_content", "<p>Unsubscribe <% here %></p>"),
+					resource.TestCheckResourceAttr(resourceName, "plain_content", "Unsubscribe: <% %>"),
+					resource.TestCheckResourceAttr(resourceName, "landing", "<p>You have been unsubscribed.</p>"),
+					resource.TestCheckResourceAttr(resourceName, "replace", "[unsubscribe]"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/unsubscribed"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importClickTrackingSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "html_content",
+			},
+			// Update and Read testing
+			{
+				Config: testAccSubscriptionTrackingSettingsResourceConfig(false, "<p>Unsubscribe from these emails <% here %></p>", "Unsubscribe from these emails: <% %>", "<p>You have been unsubscribed from these emails.</p>", "[unsubscribe_link]", "https://example.com/unsubscribed?from=email"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "html_content", "<p>Unsubscribe from these emails <% here %></p>"),
+					resource.TestCheckResourceAttr(resourceName, "plain_content", "Unsubscribe from these emails: <% %>"),
+					resource.TestCheckResourceAttr(resourceName, "landing", "<p>You have been unsubscribed from these emails.</p>"),
+					resource.TestCheckResourceAttr(resourceName, "replace", "[unsubscribe_link]"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/unsubscribed?from=email"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubscriptionTrackingSettingsResourceConfig(enabled bool, htmlContent, plainContent, landing, replace, url string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_subscription_tracking_settings" "test" {
+  enabled       = %t
+  html_content  = %q
+  plain_content = %q
+  landing       = %q
+  replace       = %q
+  url           = %q
+}
+`, enabled, htmlContent, plainContent, landing, replace, url)
+}
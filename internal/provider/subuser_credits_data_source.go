@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &subuserCreditsDataSource{}
+	_ datasource.DataSourceWithConfigure = &subuserCreditsDataSource{}
+)
+
+func newSubuserCreditsDataSource() datasource.DataSource {
+	return &subuserCreditsDataSource{}
+}
+
+type subuserCreditsDataSource struct {
+	client *sendgrid.Client
+}
+
+type subuserCreditsDataSourceModel struct {
+	Username       types.String `tfsdk:"username"`
+	Type           types.String `tfsdk:"type"`
+	Total          types.Int64  `tfsdk:"total"`
+	ResetFrequency types.String `tfsdk:"reset_frequency"`
+	Remain         types.Int64  `tfsdk:"remain"`
+	Used           types.Int64  `tfsdk:"used"`
+}
+
+func (d *subuserCreditsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subuser_credits"
+}
+
+func (d *subuserCreditsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *subuserCreditsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Retrieve the credit allocation and current usage of a subuser.
+
+Use it with a ` + "`check`" + ` block or a precondition to enforce a send budget, for example to fail when a subuser has used most of its credits.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username of the subuser.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of credit allocation: `unlimited`, `recurring` or `nonrecurring`.",
+				Computed:            true,
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "The number of emails the subuser can send. `0` for `unlimited` credits.",
+				Computed:            true,
+			},
+			"reset_frequency": schema.StringAttribute{
+				MarkdownDescription: "How often `recurring` credits are reset: `daily`, `weekly` or `monthly`. Empty for the other types.",
+				Computed:            true,
+			},
+			"remain": schema.Int64Attribute{
+				MarkdownDescription: "The number of credits the subuser has left.",
+				Computed:            true,
+			},
+			"used": schema.Int64Attribute{
+				MarkdownDescription: "The number of credits the subuser has used.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *subuserCreditsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var s subuserCreditsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := s.Username.ValueString()
+
+	o, err := d.client.GetCreditsForSubuser(ctx, username)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading subuser credits",
+			fmt.Sprintf("Unable to read credits of subuser (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+
+	s = subuserCreditsDataSourceModel{
+		Username:       types.StringValue(username),
+		Type:           types.StringValue(o.Type),
+		Total:          types.Int64Value(int64(o.Total)),
+		ResetFrequency: types.StringValue(o.ResetFrequency),
+		Remain:         types.Int64Value(int64(o.Remain)),
+		Used:           types.Int64Value(int64(o.Used)),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
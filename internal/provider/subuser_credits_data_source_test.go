@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubuserCreditsDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_subuser_credits.test"
+
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSubuserCreditsDataSourceConfig(username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "type", "nonrecurring"),
+					resource.TestCheckResourceAttr(resourceName, "total", "100"),
+					resource.TestCheckResourceAttrSet(resourceName, "remain"),
+					resource.TestCheckResourceAttrSet(resourceName, "used"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSubuserCreditsDataSourceUsage(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "data.sendgrid_subuser_credits.test"
+
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubuserCreditsDataSourceConfig(username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "remain", "100"),
+					resource.TestCheckResourceAttr(resourceName, "used", "0"),
+				),
+			},
+			// The subuser sends emails between runs.
+			{
+				PreConfig: func() {
+					if err := server.UseCredits(username, 30); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccSubuserCreditsDataSourceConfig(username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "remain", "70"),
+					resource.TestCheckResourceAttr(resourceName, "used", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubuserCreditsDataSourceConfig(username string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_subuser" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+  password = "test-acc-password-1!"
+  ips      = [%[2]q]
+}
+
+resource "sendgrid_subuser_credits" "test" {
+  username = sendgrid_subuser.test.username
+  type     = "nonrecurring"
+  total    = 100
+}
+
+data "sendgrid_subuser_credits" "test" {
+  username = sendgrid_subuser_credits.test.username
+}
+`, username, os.Getenv("IP_ADDRESS"))
+}
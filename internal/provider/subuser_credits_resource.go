@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &subuserCreditsResource{}
+var _ resource.ResourceWithImportState = &subuserCreditsResource{}
+var _ resource.ResourceWithIdentity = &subuserCreditsResource{}
+var _ resource.ResourceWithValidateConfig = &subuserCreditsResource{}
+
+const (
+	subuserCreditsTypeUnlimited    = "unlimited"
+	subuserCreditsTypeRecurring    = "recurring"
+	subuserCreditsTypeNonrecurring = "nonrecurring"
+)
+
+func newSubuserCreditsResource() resource.Resource {
+	return &subuserCreditsResource{}
+}
+
+type subuserCreditsResource struct {
+	client *sendgrid.Client
+}
+
+type subuserCreditsResourceModel struct {
+	Username       types.String `tfsdk:"username"`
+	Type           types.String `tfsdk:"type"`
+	Total          types.Int64  `tfsdk:"total"`
+	ResetFrequency types.String `tfsdk:"reset_frequency"`
+	Remain         types.Int64  `tfsdk:"remain"`
+	Used           types.Int64  `tfsdk:"used"`
+}
+
+type subuserCreditsIdentityModel struct {
+	Username types.String `tfsdk:"username"`
+}
+
+func (m subuserCreditsResourceModel) identity() subuserCreditsIdentityModel {
+	return subuserCreditsIdentityModel{
+		Username: m.Username,
+	}
+}
+
+func (r *subuserCreditsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subuser_credits"
+}
+
+func (r *subuserCreditsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the credit allocation of a subuser.
+
+Credits limit the number of emails a subuser can send. A subuser has ` + "`unlimited`" + ` credits by default; ` + "`recurring`" + ` credits are reset to ` + "`total`" + ` every ` + "`reset_frequency`" + `, and ` + "`nonrecurring`" + ` credits are a one-off allocation of ` + "`total`" + ` emails.
+
+Destroying this resource gives the subuser unlimited credits again.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/subusers-api/update-credits-for-subuser).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username of the subuser.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of credit allocation. One of `unlimited`, `recurring` or `nonrecurring`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringOneOf(
+						subuserCreditsTypeUnlimited,
+						subuserCreditsTypeRecurring,
+						subuserCreditsTypeNonrecurring,
+					),
+				},
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "The number of emails the subuser can send. Required for `recurring` and `nonrecurring` credits, and not allowed for `unlimited` credits.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"reset_frequency": schema.StringAttribute{
+				MarkdownDescription: "How often `recurring` credits are reset to `total`. One of `daily`, `weekly` or `monthly`. Required for `recurring` credits, and not allowed for the other types.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf("daily", "weekly", "monthly"),
+				},
+			},
+			"remain": schema.Int64Attribute{
+				MarkdownDescription: "The number of credits the subuser has left.",
+				Computed:            true,
+			},
+			"used": schema.Int64Attribute{
+				MarkdownDescription: "The number of credits the subuser has used.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *subuserCreditsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"username": identityschema.StringAttribute{
+				Description:       "The username of the subuser.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *subuserCreditsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data subuserCreditsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+	creditsType := data.Type.ValueString()
+
+	switch creditsType {
+	case subuserCreditsTypeRecurring, subuserCreditsTypeNonrecurring:
+		if data.Total.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("total"),
+				"Missing total",
+				fmt.Sprintf("total is required for %s credits.", creditsType),
+			)
+		}
+	case subuserCreditsTypeUnlimited:
+		if !data.Total.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("total"),
+				"Invalid total",
+				"total is not allowed for unlimited credits.",
+			)
+		}
+	}
+
+	switch creditsType {
+	case subuserCreditsTypeRecurring:
+		if data.ResetFrequency.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("reset_frequency"),
+				"Missing reset_frequency",
+				"reset_frequency is required for recurring credits.",
+			)
+		}
+	case subuserCreditsTypeNonrecurring, subuserCreditsTypeUnlimited:
+		if !data.ResetFrequency.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("reset_frequency"),
+				"Invalid reset_frequency",
+				fmt.Sprintf("reset_frequency is only allowed for recurring credits, got %s credits.", creditsType),
+			)
+		}
+	}
+}
+
+func (r *subuserCreditsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *subuserCreditsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subuserCreditsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := plan.Username.ValueString()
+
+	o, err := r.client.UpdateCreditsForSubuser(ctx, username, &sendgrid.InputUpdateCreditsForSubuser{
+		Type:           plan.Type.ValueString(),
+		Total:          int(plan.Total.ValueInt64()),
+		ResetFrequency: plan.ResetFrequency.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating subuser credits",
+			fmt.Sprintf("Unable to update credits of subuser (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+
+	plan.Remain = types.Int64Value(int64(o.Remain))
+	plan.Used = types.Int64Value(int64(o.Used))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subuserCreditsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subuserCreditsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+
+	o, err := r.client.GetCreditsForSubuser(ctx, username)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading subuser credits",
+			fmt.Sprintf("Unable to read credits of subuser (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+
+	state = subuserCreditsModelFrom(username, o)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subuserCreditsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data subuserCreditsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := data.Username.ValueString()
+
+	o, err := r.client.UpdateCreditsForSubuser(ctx, username, &sendgrid.InputUpdateCreditsForSubuser{
+		Type:           data.Type.ValueString(),
+		Total:          int(data.Total.ValueInt64()),
+		ResetFrequency: data.ResetFrequency.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating subuser credits",
+			fmt.Sprintf("Unable to update credits of subuser (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+
+	data.Remain = types.Int64Value(int64(o.Remain))
+	data.Used = types.Int64Value(int64(o.Used))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subuserCreditsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subuserCreditsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+
+	// Credits cannot be deleted, so give the subuser unlimited credits again.
+	if _, err := r.client.UpdateCreditsForSubuser(ctx, username, &sendgrid.InputUpdateCreditsForSubuser{
+		Type: subuserCreditsTypeUnlimited,
+	}); err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting subuser credits",
+			fmt.Sprintf("Unable to reset credits of subuser (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+}
+
+func (r *subuserCreditsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	username := importIDOrIdentity(ctx, req, resp, "username")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := r.client.GetCreditsForSubuser(ctx, username)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing subuser credits",
+			fmt.Sprintf("Unable to read credits of subuser (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+
+	data := subuserCreditsModelFrom(username, o)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// subuserCreditsModelFrom converts the credits of a subuser to the resource
+// model. total and reset_frequency are null when they do not apply to the
+// type, as they must be in configuration.
+func subuserCreditsModelFrom(username string, o *sendgrid.OutputGetCreditsForSubuser) subuserCreditsResourceModel {
+	data := subuserCreditsResourceModel{
+		Username:       types.StringValue(username),
+		Type:           types.StringValue(o.Type),
+		Total:          types.Int64Null(),
+		ResetFrequency: types.StringNull(),
+		Remain:         types.Int64Value(int64(o.Remain)),
+		Used:           types.Int64Value(int64(o.Used)),
+	}
+	if o.Type != subuserCreditsTypeUnlimited {
+		data.Total = types.Int64Value(int64(o.Total))
+	}
+	if o.Type == subuserCreditsTypeRecurring {
+		data.ResetFrequency = types.StringValue(o.ResetFrequency)
+	}
+	return data
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubuserCreditsResource(t *testing.T) {
+	resourceName := "sendgrid_subuser_credits.test"
+
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSubuserCreditsResourceConfig(username, `
+  type            = "recurring"
+  total           = 1000
+  reset_frequency = "monthly"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "type", "recurring"),
+					resource.TestCheckResourceAttr(resourceName, "total", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "reset_frequency", "monthly"),
+					resource.TestCheckResourceAttr(resourceName, "remain", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "used", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        username,
+				ImportStateVerifyIdentifierAttribute: "username",
+			},
+			// Update and Read testing
+			{
+				Config: testAccSubuserCreditsResourceConfig(username, `
+  type  = "nonrecurring"
+  total = 500
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "nonrecurring"),
+					resource.TestCheckResourceAttr(resourceName, "total", "500"),
+					resource.TestCheckNoResourceAttr(resourceName, "reset_frequency"),
+					resource.TestCheckResourceAttr(resourceName, "remain", "500"),
+				),
+			},
+			{
+				Config: testAccSubuserCreditsResourceConfig(username, `
+  type = "unlimited"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "unlimited"),
+					resource.TestCheckNoResourceAttr(resourceName, "total"),
+					resource.TestCheckNoResourceAttr(resourceName, "reset_frequency"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSubuserCreditsResourceInvalidConfig(t *testing.T) {
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubuserCreditsResourceConfig(username, `
+  type  = "recurring"
+  total = 1000
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`reset_frequency is required for recurring credits`),
+			},
+			{
+				Config: testAccSubuserCreditsResourceConfig(username, `
+  type  = "unlimited"
+  total = 1000
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`total is not allowed for unlimited credits`),
+			},
+			{
+				Config: testAccSubuserCreditsResourceConfig(username, `
+  type            = "nonrecurring"
+  total           = 1000
+  reset_frequency = "daily"
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`reset_frequency is only allowed for recurring credits`),
+			},
+		},
+	})
+}
+
+func testAccSubuserCreditsResourceConfig(username, credits string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_subuser" "test" {
+  username = %[1]q
+  email    = "%[1]s@example.com"
+  password = "test-acc-password-1!"
+  ips      = [%[2]q]
+}
+
+resource "sendgrid_subuser_credits" "test" {
+  username = sendgrid_subuser.test.username
+%[3]s}
+`, username, os.Getenv("IP_ADDRESS"), credits)
+}
@@ -28,6 +28,7 @@ type subuserDataSourceModel struct {
 	ID       types.Int64  `tfsdk:"id"`
 	Username types.String `tfsdk:"username"`
 	Email    types.String `tfsdk:"email"`
+	Disabled types.Bool   `tfsdk:"disabled"`
 }
 
 func (d *subuserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -74,6 +75,10 @@ For more detailed information, please see the [SendGrid documentation](https://d
 				MarkdownDescription: "The email of the subuser.",
 				Computed:            true,
 			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the subuser is disabled.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -102,6 +107,7 @@ func (d *subuserDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		ID:       types.Int64Value(subuser.ID),
 		Username: types.StringValue(subuser.Username),
 		Email:    types.StringValue(subuser.Email),
+		Disabled: types.BoolValue(subuser.Disabled),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &s)...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResourceWithConfigure = &subuserListResource{}
+
+func newSubuserListResource() list.ListResource {
+	return &subuserListResource{}
+}
+
+type subuserListResource struct {
+	subuserResource
+}
+
+type subuserListResourceModel struct {
+	UsernameRegex types.String `tfsdk:"username_regex"`
+	Region        types.String `tfsdk:"region"`
+	Disabled      types.Bool   `tfsdk:"disabled"`
+}
+
+func (r *subuserListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the subusers of your SendGrid account. SendGrid does not return the passwords, IP addresses and website access of subusers, so they are not set.",
+		Attributes: map[string]listschema.Attribute{
+			"username_regex": listRegexFilterAttribute("subusers", "username"),
+			"region": listschema.StringAttribute{
+				MarkdownDescription: "Only list the subusers in this region, `global` or `eu`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf("global", "eu"),
+				},
+			},
+			"disabled": listschema.BoolAttribute{
+				MarkdownDescription: "Only list the disabled subusers when `true`, or the enabled subusers when `false`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *subuserListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data subuserListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	usernameRegex, err := newRegexFilter(data.UsernameRegex)
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing subusers",
+			fmt.Sprintf("Unable to compile username_regex, got error: %s", err),
+		)
+		return
+	}
+
+	items, err := listAllSubusers(ctx, r.client, sendgrid.InputGetSubusers{
+		Region:        data.Region.ValueString(),
+		IncludeRegion: true,
+	})
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing subusers",
+			fmt.Sprintf("Unable to list subusers, got error: %s", err),
+		)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		push = limitResults(req, push)
+		for _, v := range items {
+			if !usernameRegex.match(v.Username) {
+				continue
+			}
+			if !data.Disabled.IsNull() && v.Disabled != data.Disabled.ValueBool() {
+				continue
+			}
+			m := subuserImportModelFrom(v)
+			if !push(newListResult(ctx, req, v.Username, m.identity(), &m)) {
+				return
+			}
+		}
+	}
+}
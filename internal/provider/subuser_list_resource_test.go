@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSubuserListResource(t *testing.T) {
+	ips := []string{os.Getenv("IP_ADDRESS")}
+
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+	password := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubuserResourceConfig(username, email, password, escapesStrings(ips)),
+			},
+			// NOTE: There is no import by identity step because importing a
+			// subuser never sets its password and ips, so the import block
+			// would plan an update.
+			{
+				Query:  true,
+				Config: testAccSubuserListResourceQuery(username),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("sendgrid_subuser.test", 1),
+					querycheck.ExpectIdentity("sendgrid_subuser.test", map[string]knownvalue.Check{
+						"username": knownvalue.StringExact(username),
+					}),
+					querycheck.ExpectResourceKnownValues("sendgrid_subuser.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(username)),
+						[]querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("email"), KnownValue: knownvalue.StringExact(email)},
+							{Path: tfjsonpath.New("password"), KnownValue: knownvalue.Null()},
+						},
+					),
+					querycheck.ExpectLength("sendgrid_subuser.disabled", 0),
+				},
+			},
+		},
+	})
+}
+
+func testAccSubuserListResourceQuery(username string) string {
+	return fmt.Sprintf(`
+list "sendgrid_subuser" "test" {
+	provider         = sendgrid
+	include_resource = true
+
+	config {
+		username_regex = %[1]q
+	}
+}
+
+list "sendgrid_subuser" "disabled" {
+	provider = sendgrid
+
+	config {
+		username_regex = %[1]q
+		disabled       = true
+	}
+}
+`, "^"+regexp.QuoteMeta(username)+"$")
+}
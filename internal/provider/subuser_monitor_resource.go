@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &subuserMonitorResource{}
+var _ resource.ResourceWithImportState = &subuserMonitorResource{}
+var _ resource.ResourceWithIdentity = &subuserMonitorResource{}
+
+func newSubuserMonitorResource() resource.Resource {
+	return &subuserMonitorResource{}
+}
+
+type subuserMonitorResource struct {
+	client *sendgrid.Client
+}
+
+type subuserMonitorResourceModel struct {
+	Username  types.String `tfsdk:"username"`
+	Email     types.String `tfsdk:"email"`
+	Frequency types.Int64  `tfsdk:"frequency"`
+}
+
+type subuserMonitorIdentityModel struct {
+	Username types.String `tfsdk:"username"`
+}
+
+func (m subuserMonitorResourceModel) identity() subuserMonitorIdentityModel {
+	return subuserMonitorIdentityModel{
+		Username: m.Username,
+	}
+}
+
+func (r *subuserMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subuser_monitor"
+}
+
+func (r *subuserMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides a subuser monitor resource.
+
+Subuser monitor settings send a sample of the emails a subuser sends to a given address, so that you can review what the subuser is sending.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/api-reference/subuser-monitor-settings).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username of the subuser to monitor.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address to send the sampled emails to.",
+				Required:            true,
+			},
+			"frequency": schema.Int64Attribute{
+				MarkdownDescription: "The number of emails the subuser sends between each sample.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *subuserMonitorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"username": identityschema.StringAttribute{
+				Description:       "The username of the subuser.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *subuserMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *subuserMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subuserMonitorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := plan.Username.ValueString()
+
+	o, err := createSubuserMonitor(ctx, r.client, username, &subuserMonitor{
+		Email:     plan.Email.ValueString(),
+		Frequency: plan.Frequency.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating subuser monitor",
+			fmt.Sprintf("Unable to create subuser monitor (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+
+	plan = subuserMonitorResourceModel{
+		Username:  types.StringValue(username),
+		Email:     types.StringValue(o.Email),
+		Frequency: types.Int64Value(o.Frequency),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subuserMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subuserMonitorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+
+	o, err := getSubuserMonitor(ctx, r.client, username)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading subuser monitor",
+			fmt.Sprintf("Unable to read subuser monitor (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+
+	state.Email = types.StringValue(o.Email)
+	state.Frequency = types.Int64Value(o.Frequency)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subuserMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data subuserMonitorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := data.Username.ValueString()
+
+	o, err := updateSubuserMonitor(ctx, r.client, username, &subuserMonitor{
+		Email:     data.Email.ValueString(),
+		Frequency: data.Frequency.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating subuser monitor",
+			fmt.Sprintf("Unable to update subuser monitor (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+
+	data = subuserMonitorResourceModel{
+		Username:  types.StringValue(username),
+		Email:     types.StringValue(o.Email),
+		Frequency: types.Int64Value(o.Frequency),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subuserMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subuserMonitorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+
+	if err := deleteSubuserMonitor(ctx, r.client, username); err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting subuser monitor",
+			fmt.Sprintf("Unable to delete subuser monitor (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+}
+
+func (r *subuserMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	username := importIDOrIdentity(ctx, req, resp, "username")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getSubuserMonitor(ctx, r.client, username)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing subuser monitor",
+			fmt.Sprintf("Unable to read subuser monitor (username: %s), got error: %s", username, err),
+		)
+		return
+	}
+
+	data := subuserMonitorResourceModel{
+		Username:  types.StringValue(username),
+		Email:     types.StringValue(o.Email),
+		Frequency: types.Int64Value(o.Frequency),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubuserMonitorResource(t *testing.T) {
+	resourceName := "sendgrid_subuser_monitor.test"
+
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+	password := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	monitorEmail := fmt.Sprintf("test-acc-monitor-%s@example.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSubuserMonitorResourceConfig(username, email, password, monitorEmail, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "email", monitorEmail),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "10"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        username,
+				ImportStateVerifyIdentifierAttribute: "username",
+			},
+			// Update and Read testing
+			{
+				Config: testAccSubuserMonitorResourceConfig(username, email, password, monitorEmail, 500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "email", monitorEmail),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "500"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubuserMonitorResourceConfig(username, email, password, monitorEmail string, frequency int) string {
+	return fmt.Sprintf(`
+resource "sendgrid_subuser" "test" {
+  username = %[1]q
+  email    = %[2]q
+  password = %[3]q
+  ips      = [%[4]q]
+}
+
+resource "sendgrid_subuser_monitor" "test" {
+  username  = sendgrid_subuser.test.username
+  email     = %[5]q
+  frequency = %[6]d
+}
+`, username, email, password, os.Getenv("IP_ADDRESS"), monitorEmail, frequency)
+}
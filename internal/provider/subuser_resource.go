@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -25,6 +27,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &subuserResource{}
 var _ resource.ResourceWithImportState = &subuserResource{}
+var _ resource.ResourceWithIdentity = &subuserResource{}
 
 func newSubuserResource() resource.Resource {
 	return &subuserResource{}
@@ -43,6 +46,18 @@ type subuserResourceModel struct {
 	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
 	Ips               types.Set    `tfsdk:"ips"`
 	Region            types.String `tfsdk:"region"`
+	Disabled          types.Bool   `tfsdk:"disabled"`
+	WebsiteAccess     types.Bool   `tfsdk:"website_access"`
+}
+
+type subuserIdentityModel struct {
+	Username types.String `tfsdk:"username"`
+}
+
+func (m subuserResourceModel) identity() subuserIdentityModel {
+	return subuserIdentityModel{
+		Username: m.Username,
+	}
 }
 
 func (r *subuserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -134,6 +149,29 @@ For more detailed information, please see the [SendGrid documentation](https://d
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the subuser is disabled. A disabled subuser can neither send email nor log in, so setting this to `true` pauses the subuser without deleting it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"website_access": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the subuser can log in to the SendGrid website. Its API access is not affected. The SendGrid API does not return this setting, so after `terraform import` the state value is null and the configured value is applied on the next apply.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *subuserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"username": identityschema.StringAttribute{
+				Description:       "The username of the subuser.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -183,16 +221,13 @@ func (r *subuserResource) Create(ctx context.Context, req resource.CreateRequest
 		password = config.PasswordWO.ValueString()
 	}
 
-	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateSubuser(ctx, &sendgrid.InputCreateSubuser{
-			Username:      plan.Username.ValueString(),
-			Email:         plan.Email.ValueString(),
-			Password:      password,
-			Ips:           ips,
-			Region:        plan.Region.ValueString(),
-			IncludeRegion: true,
-		})
+	o, err := r.client.CreateSubuser(ctx, &sendgrid.InputCreateSubuser{
+		Username:      plan.Username.ValueString(),
+		Email:         plan.Email.ValueString(),
+		Password:      password,
+		Ips:           ips,
+		Region:        plan.Region.ValueString(),
+		IncludeRegion: true,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -202,21 +237,15 @@ func (r *subuserResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateSubuser)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating subuser",
-			"Failed to assert type *sendgrid.OutputCreateSubuser",
-		)
-		return
-	}
-
 	ipsState, diags := types.SetValueFrom(ctx, types.StringType, ips)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Save the subuser as created, enabled and with website access, before
+	// changing either: should that fail, Terraform taints the subuser rather
+	// than lose track of it.
 	data := subuserResourceModel{
 		ID:                types.Int64Value(o.UserID),
 		Username:          types.StringValue(o.Username),
@@ -225,11 +254,48 @@ func (r *subuserResource) Create(ctx context.Context, req resource.CreateRequest
 		PasswordWOVersion: plan.PasswordWOVersion,
 		Ips:               ipsState,
 		Region:            types.StringValue(o.Region),
+		Disabled:          types.BoolValue(false),
+		WebsiteAccess:     types.BoolValue(true),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	username := o.Username
+
+	if plan.Disabled.ValueBool() {
+		if err := r.client.UpdateSubuserStatus(ctx, username, &sendgrid.InputUpdateSubuserStatus{
+			Disabled: true,
+		}); err != nil {
+			resp.Diagnostics.AddError(
+				"Creating subuser",
+				fmt.Sprintf("Unable to disable subuser (username: %s), got error: %s", username, err),
+			)
+			return
+		}
+		data.Disabled = types.BoolValue(true)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.WebsiteAccess.ValueBool() {
+		if err := updateSubuserWebsiteAccess(ctx, r.client, username, false); err != nil {
+			resp.Diagnostics.AddError(
+				"Creating subuser",
+				fmt.Sprintf("Unable to disable website access of subuser (username: %s), got error: %s", username, err),
+			)
+			return
+		}
+		data.WebsiteAccess = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 }
 
 func (r *subuserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -266,8 +332,10 @@ func (r *subuserResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.ID = types.Int64Value(subuser.ID)
 	state.Email = types.StringValue(subuser.Email)
 	state.Region = types.StringValue(subuser.Region)
-	// NOTE: ips and password values are preserved from state because the SendGrid API does not return them.
+	state.Disabled = types.BoolValue(subuser.Disabled)
+	// NOTE: ips, password and website_access values are preserved from state because the SendGrid API does not return them.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -300,8 +368,31 @@ func (r *subuserResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
+	if !data.Disabled.Equal(state.Disabled) {
+		if err := r.client.UpdateSubuserStatus(ctx, username, &sendgrid.InputUpdateSubuserStatus{
+			Disabled: data.Disabled.ValueBool(),
+		}); err != nil {
+			resp.Diagnostics.AddError(
+				"Updating subuser",
+				fmt.Sprintf("Unable to update subuser's status (username: %s), got error: %s", username, err),
+			)
+			return
+		}
+	}
+
+	if !data.WebsiteAccess.Equal(state.WebsiteAccess) {
+		if err := updateSubuserWebsiteAccess(ctx, r.client, username, data.WebsiteAccess.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(
+				"Updating subuser",
+				fmt.Sprintf("Unable to update subuser's website access (username: %s), got error: %s", username, err),
+			)
+			return
+		}
+	}
+
 	data.ID = state.ID
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -316,10 +407,7 @@ func (r *subuserResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	username := state.Username.ValueString()
 
-	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	_, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteSubuser(ctx, username)
-	})
+	err := r.client.DeleteSubuser(ctx, username)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting subuser",
@@ -330,11 +418,10 @@ func (r *subuserResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *subuserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var data subuserResourceModel
-
-	username := req.ID
-
-	resource.ImportStatePassthroughID(ctx, path.Root("username"), req, resp)
+	username := importIDOrIdentity(ctx, req, resp, "username")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	subusers, err := r.client.GetSubusers(ctx, &sendgrid.InputGetSubusers{
 		Username:      username,
@@ -357,18 +444,26 @@ func (r *subuserResource) ImportState(ctx context.Context, req resource.ImportSt
 		return
 	}
 
-	subuser := subusers[0]
-	data = subuserResourceModel{
+	data := subuserImportModelFrom(subusers[0])
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// subuserImportModelFrom converts a subuser read from SendGrid to the resource
+// model of an imported subuser.
+func subuserImportModelFrom(subuser *sendgrid.Subuser) subuserResourceModel {
+	return subuserResourceModel{
 		ID:       types.Int64Value(subuser.ID),
 		Username: types.StringValue(subuser.Username),
 		Email:    types.StringValue(subuser.Email),
 		// NOTE: set ips to null because sendgrid api cannot get ips associated with subuser
-		Ips:    types.SetNull(types.StringType),
-		Region: types.StringValue(subuser.Region),
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+		Ips:      types.SetNull(types.StringType),
+		Region:   types.StringValue(subuser.Region),
+		Disabled: types.BoolValue(subuser.Disabled),
+		// NOTE: set website_access to null because sendgrid api cannot get it either
+		WebsiteAccess: types.BoolNull(),
 	}
 }
@@ -6,10 +6,12 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccSubuserResource(t *testing.T) {
@@ -50,7 +52,7 @@ func TestAccSubuserResource(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ips", "password"},
+				ImportStateVerifyIgnore: []string{"ips", "password", "website_access"},
 				ImportStateId:           username,
 			},
 			// Update and Read testing
@@ -85,3 +87,103 @@ func escapesStrings(x []string) (y []string) {
 	}
 	return
 }
+
+func TestAccSubuserResourceStatus(t *testing.T) {
+	resourceName := "sendgrid_subuser.test"
+
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+	password := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSubuserResourceStatusConfig(username, email, password, false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "website_access", "false"),
+				),
+			},
+			// Pause the subuser
+			{
+				Config: testAccSubuserResourceStatusConfig(username, email, password, true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "website_access", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ips", "password", "website_access"},
+				ImportStateId:           username,
+			},
+			// Resume the subuser and restore website access
+			{
+				Config: testAccSubuserResourceStatusConfig(username, email, password, false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "website_access", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSubuserResource_partialCreate(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_subuser.test"
+
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+	password := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The subuser is created, but its website access cannot be
+			// disabled.
+			{
+				PreConfig: func() {
+					server.FailNext("PATCH", "/v3/subusers/"+username+"/website_access")
+				},
+				Config:      testAccSubuserResourceStatusConfig(username, email, password, false, false),
+				ExpectError: regexp.MustCompile(`Unable to disable website access of subuser`),
+			},
+			// The subuser was saved and tainted, so it is replaced rather than
+			// created again under a username already taken.
+			{
+				Config: testAccSubuserResourceStatusConfig(username, email, password, false, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "website_access", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubuserResourceStatusConfig(username, email, password string, disabled, websiteAccess bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_subuser" "test" {
+  username       = %[1]q
+  email          = %[2]q
+  password       = %[3]q
+  ips            = [%[4]q]
+  disabled       = %[5]t
+  website_access = %[6]t
+}
+`, username, email, password, os.Getenv("IP_ADDRESS"), disabled, websiteAccess)
+}
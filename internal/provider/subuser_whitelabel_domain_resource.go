@@ -9,8 +9,8 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &subuserWhitelabelDomainResource{}
 var _ resource.ResourceWithImportState = &subuserWhitelabelDomainResource{}
+var _ resource.ResourceWithIdentity = &subuserWhitelabelDomainResource{}
 
 func newSubuserWhitelabelDomainResource() resource.Resource {
 	return &subuserWhitelabelDomainResource{}
@@ -37,6 +38,18 @@ type subuserWhitelabelDomainResourceModel struct {
 	Subuser  types.String `tfsdk:"subuser"`
 }
 
+type subuserWhitelabelDomainIdentityModel struct {
+	DomainID types.Int64  `tfsdk:"domain_id"`
+	Subuser  types.String `tfsdk:"subuser"`
+}
+
+func (m subuserWhitelabelDomainResourceModel) identity() subuserWhitelabelDomainIdentityModel {
+	return subuserWhitelabelDomainIdentityModel{
+		DomainID: m.DomainID,
+		Subuser:  m.Subuser,
+	}
+}
+
 func (r *subuserWhitelabelDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_subuser_whitelabel_domain"
 }
@@ -72,6 +85,21 @@ The parent account must first authenticate and validate the domain before associ
 	}
 }
 
+func (r *subuserWhitelabelDomainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"domain_id": identityschema.Int64Attribute{
+				Description:       "The ID of the authenticated domain. When omitted on import, the domain associated with the subuser is imported.",
+				OptionalForImport: true,
+			},
+			"subuser": identityschema.StringAttribute{
+				Description:       "The username of the subuser.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *subuserWhitelabelDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -114,6 +142,7 @@ func (r *subuserWhitelabelDomainResource) Create(ctx context.Context, req resour
 	data.ID = types.StringValue(id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *subuserWhitelabelDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -144,6 +173,7 @@ func (r *subuserWhitelabelDomainResource) Read(ctx context.Context, req resource
 	}
 
 	// Nothing else to refresh; keep state as-is.
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *subuserWhitelabelDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -176,6 +206,7 @@ func (r *subuserWhitelabelDomainResource) ImportState(ctx context.Context, req r
 	// Support two formats:
 	//   - "<subuser>" (just the subuser name)
 	//   - "<domain_id>:<subuser>"
+	// and the identity, whose domain_id is optional likewise.
 	rawID := req.ID
 
 	var (
@@ -184,8 +215,16 @@ func (r *subuserWhitelabelDomainResource) ImportState(ctx context.Context, req r
 		err      error
 	)
 
-	// Try to parse "domain_id:subuser"
-	if parts := strings.SplitN(rawID, ":", 2); len(parts) == 2 {
+	if rawID == "" {
+		var identity subuserWhitelabelDomainIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		domainID = identity.DomainID.ValueInt64()
+		subuser = identity.Subuser.ValueString()
+	} else if parts := strings.SplitN(rawID, ":", 2); len(parts) == 2 {
+		// Try to parse "domain_id:subuser"
 		domainID, err = strconv.ParseInt(parts[0], 10, 64)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -196,8 +235,12 @@ func (r *subuserWhitelabelDomainResource) ImportState(ctx context.Context, req r
 		}
 		subuser = parts[1]
 	} else {
-		// Treat the whole ID as subuser; query API for domain
+		// Treat the whole ID as subuser
 		subuser = rawID
+	}
+
+	if domainID == 0 {
+		// Query API for the domain of the subuser
 		assoc, err := r.client.GetAuthenticatedDomainAssociatedWithSubuser(ctx, subuser)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -216,10 +259,12 @@ func (r *subuserWhitelabelDomainResource) ImportState(ctx context.Context, req r
 		domainID = assoc.ID
 	}
 
-	// Synthetic ID "<domain_id>:<subuser>"
-	syntheticID := fmt.Sprintf("%d:%s", domainID, subuser)
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), syntheticID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subuser"), subuser)...)
+	data := subuserWhitelabelDomainResourceModel{
+		// Synthetic ID "<domain_id>:<subuser>"
+		ID:       types.StringValue(fmt.Sprintf("%d:%s", domainID, subuser)),
+		DomainID: types.Int64Value(domainID),
+		Subuser:  types.StringValue(subuser),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
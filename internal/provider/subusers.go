@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+// The sendgrid client does not cover the website access and monitor settings
+// of subusers, so they are called through its generic request helpers.
+
+type inputUpdateSubuserWebsiteAccess struct {
+	Disabled bool `json:"disabled"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/subusers-api/enabledisable-website-access-to-a-subuser
+func updateSubuserWebsiteAccess(ctx context.Context, client *sendgrid.Client, username string, enabled bool) error {
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("/subusers/%s/website_access", username), &inputUpdateSubuserWebsiteAccess{
+		Disabled: !enabled,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+// subuserMonitor is both the request and the response body of
+// /v3/subusers/{username}/monitor.
+type subuserMonitor struct {
+	Email     string `json:"email"`
+	Frequency int64  `json:"frequency"`
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/subuser-monitor-settings
+func getSubuserMonitor(ctx context.Context, client *sendgrid.Client, username string) (*subuserMonitor, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("/subusers/%s/monitor", username), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	o := new(subuserMonitor)
+	if err := client.Do(ctx, req, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func createSubuserMonitor(ctx context.Context, client *sendgrid.Client, username string, input *subuserMonitor) (*subuserMonitor, error) {
+	return writeSubuserMonitor(ctx, client, "POST", username, input)
+}
+
+func updateSubuserMonitor(ctx context.Context, client *sendgrid.Client, username string, input *subuserMonitor) (*subuserMonitor, error) {
+	return writeSubuserMonitor(ctx, client, "PUT", username, input)
+}
+
+func writeSubuserMonitor(ctx context.Context, client *sendgrid.Client, method, username string, input *subuserMonitor) (*subuserMonitor, error) {
+	req, err := client.NewRequest(method, fmt.Sprintf("/subusers/%s/monitor", username), input)
+	if err != nil {
+		return nil, err
+	}
+
+	o := new(subuserMonitor)
+	if err := client.Do(ctx, req, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func deleteSubuserMonitor(ctx context.Context, client *sendgrid.Client, username string) error {
+	req, err := client.NewRequest("DELETE", fmt.Sprintf("/subusers/%s/monitor", username), nil)
+	if err != nil {
+		return err
+	}
+
+	return client.Do(ctx, req, nil)
+}
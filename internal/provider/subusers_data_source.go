@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &subusersDataSource{}
+	_ datasource.DataSourceWithConfigure = &subusersDataSource{}
+)
+
+func newSubusersDataSource() datasource.DataSource {
+	return &subusersDataSource{}
+}
+
+type subusersDataSource struct {
+	client *sendgrid.Client
+}
+
+type subusersDataSourceModel struct {
+	UsernameRegex types.String    `tfsdk:"username_regex"`
+	Region        types.String    `tfsdk:"region"`
+	Disabled      types.Bool      `tfsdk:"disabled"`
+	Subusers      []subusersModel `tfsdk:"subusers"`
+}
+
+type subusersModel struct {
+	ID       types.String `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	Email    types.String `tfsdk:"email"`
+	Region   types.String `tfsdk:"region"`
+	Disabled types.Bool   `tfsdk:"disabled"`
+}
+
+func (d *subusersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subusers"
+}
+
+func (d *subusersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *subusersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the subusers of your SendGrid account.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"username_regex": regexFilterAttribute("subusers", "username"),
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only list the subusers in this region, `global` or `eu`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf("global", "eu"),
+				},
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Only list the disabled subusers when `true`, or the enabled subusers when `false`.",
+				Optional:            true,
+			},
+			"subusers": schema.ListNestedAttribute{
+				MarkdownDescription: "The subusers matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the subuser.",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The username of the subuser.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the subuser.",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "The region of the subuser, `global` or `eu`.",
+							Computed:            true,
+						},
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if the subuser is disabled.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *subusersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data subusersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usernameRegex, err := newRegexFilter(data.UsernameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading subusers",
+			fmt.Sprintf("Unable to compile username_regex, got error: %s", err),
+		)
+		return
+	}
+
+	items, err := listAllSubusers(ctx, d.client, sendgrid.InputGetSubusers{
+		Region:        data.Region.ValueString(),
+		IncludeRegion: true,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading subusers",
+			fmt.Sprintf("Unable to list subusers, got error: %s", err),
+		)
+		return
+	}
+
+	data.Subusers = []subusersModel{}
+	for _, v := range items {
+		if !usernameRegex.match(v.Username) {
+			continue
+		}
+		if !data.Disabled.IsNull() && v.Disabled != data.Disabled.ValueBool() {
+			continue
+		}
+		data.Subusers = append(data.Subusers, subusersModel{
+			ID:       types.StringValue(strconv.FormatInt(v.ID, 10)),
+			Username: types.StringValue(v.Username),
+			Email:    types.StringValue(v.Email),
+			Region:   types.StringValue(v.Region),
+			Disabled: types.BoolValue(v.Disabled),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
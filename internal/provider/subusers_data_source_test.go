@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubusersDataSource(t *testing.T) {
+	ipAddressAllowed := os.Getenv("IP_ADDRESS")
+	ips := []string{ipAddressAllowed}
+
+	prefix := fmt.Sprintf("test-acc-%s", acctest.RandString(12))
+	password := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSubusersDataSourceConfig(prefix, password, escapesStrings(ips)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_subusers.all", "subusers.#", "2"),
+					resource.TestCheckResourceAttr("data.sendgrid_subusers.disabled", "subusers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_subusers.disabled", "subusers.0.id", "sendgrid_subuser.disabled", "id"),
+					resource.TestCheckResourceAttr("data.sendgrid_subusers.disabled", "subusers.0.username", prefix+"-disabled"),
+					resource.TestCheckResourceAttr("data.sendgrid_subusers.disabled", "subusers.0.email", prefix+"-disabled@example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_subusers.disabled", "subusers.0.region", "global"),
+					resource.TestCheckResourceAttr("data.sendgrid_subusers.disabled", "subusers.0.disabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubusersDataSourceConfig(prefix, password string, ips []string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_subuser" "enabled" {
+	username = "%[1]s-enabled"
+	email    = "%[1]s-enabled@example.com"
+	password = "%[2]s"
+	ips      = %[3]s
+}
+
+resource "sendgrid_subuser" "disabled" {
+	username = "%[1]s-disabled"
+	email    = "%[1]s-disabled@example.com"
+	password = "%[2]s"
+	ips      = %[3]s
+	disabled = true
+}
+
+data "sendgrid_subusers" "all" {
+	username_regex = "^%[1]s-"
+
+	depends_on = [sendgrid_subuser.enabled, sendgrid_subuser.disabled]
+}
+
+data "sendgrid_subusers" "disabled" {
+	username_regex = "^%[1]s-"
+	disabled       = true
+
+	depends_on = [sendgrid_subuser.enabled, sendgrid_subuser.disabled]
+}
+`, prefix, password, ips)
+}
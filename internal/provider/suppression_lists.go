@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// suppressionListPageSize is the largest page SendGrid returns for the
+// bounce, block, spam report and invalid email lists.
+const suppressionListPageSize = 500
+
+// suppressionListFilterAttributes returns the filter attributes shared by the
+// data sources that list suppressions.
+func suppressionListFilterAttributes(what string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"start_time": schema.Int64Attribute{
+			MarkdownDescription: "Only list the " + what + " created at or after this Unix timestamp.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"end_time": schema.Int64Attribute{
+			MarkdownDescription: "Only list the " + what + " created at or before this Unix timestamp.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Only list the " + what + " of this email address.",
+			Optional:            true,
+		},
+	}
+}
+
+// suppressionListOptions builds the list options from the filter attributes.
+func suppressionListOptions(startTime, endTime types.Int64, email types.String) sendgrid.SuppressionListOptions {
+	return sendgrid.SuppressionListOptions{
+		StartTime: startTime.ValueInt64(),
+		EndTime:   endTime.ValueInt64(),
+		Email:     email.ValueString(),
+	}
+}
+
+// listAllSuppressions calls list page by page until SendGrid returns a short
+// page, and returns the items of every page.
+func listAllSuppressions[T any](ctx context.Context, opts sendgrid.SuppressionListOptions, list func(context.Context, *sendgrid.SuppressionListOptions) ([]T, error)) ([]T, error) {
+	var items []T
+	opts.Limit = suppressionListPageSize
+	for {
+		page, err := list(ctx, &opts)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if len(page) < opts.Limit {
+			return items, nil
+		}
+		opts.Offset += len(page)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+func TestListAllSuppressions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		total     int
+		wantCalls int
+	}{
+		"empty":          {total: 0, wantCalls: 1},
+		"short page":     {total: 3, wantCalls: 1},
+		"full page":      {total: suppressionListPageSize, wantCalls: 2},
+		"several pages":  {total: 2*suppressionListPageSize + 1, wantCalls: 3},
+		"exact multiple": {total: 3 * suppressionListPageSize, wantCalls: 4},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			list := func(_ context.Context, opts *sendgrid.SuppressionListOptions) ([]int, error) {
+				calls++
+				if opts.Email != "a@example.com" {
+					return nil, errors.New("filters were not passed on")
+				}
+				var page []int
+				for i := opts.Offset; i < test.total && len(page) < opts.Limit; i++ {
+					page = append(page, i)
+				}
+				return page, nil
+			}
+
+			got, err := listAllSuppressions(t.Context(), sendgrid.SuppressionListOptions{Email: "a@example.com"}, list)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != test.total {
+				t.Errorf("items = %d, want %d", len(got), test.total)
+			}
+			for i, v := range got {
+				if v != i {
+					t.Fatalf("items[%d] = %d, want %d", i, v, i)
+				}
+			}
+			if calls != test.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, test.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &teammateAccessRequestDecisionResource{}
+var _ resource.ResourceWithModifyPlan = &teammateAccessRequestDecisionResource{}
+
+const (
+	accessRequestApprove = "approve"
+	accessRequestDeny    = "deny"
+)
+
+func newTeammateAccessRequestDecisionResource() resource.Resource {
+	return &teammateAccessRequestDecisionResource{}
+}
+
+type teammateAccessRequestDecisionResource struct {
+	client *sendgrid.Client
+}
+
+type teammateAccessRequestDecisionResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	RequestID          types.Int64  `tfsdk:"request_id"`
+	Decision           types.String `tfsdk:"decision"`
+	AllowedScopeGroups types.Set    `tfsdk:"allowed_scope_groups"`
+	ScopeGroupName     types.String `tfsdk:"scope_group_name"`
+	Username           types.String `tfsdk:"username"`
+	Email              types.String `tfsdk:"email"`
+	Approved           types.Bool   `tfsdk:"approved"`
+}
+
+func (r *teammateAccessRequestDecisionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teammate_access_request_decision"
+}
+
+func (r *teammateAccessRequestDecisionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Approves or denies an access request of a restricted teammate, who asked for access to a group of scopes such as "Mail Send" or "Stats". The open requests are listed by the ` + "`sendgrid_teammate_access_requests`" + ` data source.
+
+The request is looked up when planning, so that the plan shows who asked for which group of scopes, and whether it will be approved. Approving a request grants the scopes of the group to the teammate.
+
+A decision cannot be undone: destroying this resource only removes it from the state, and the teammate keeps the scopes an approval granted. Once decided, a request is no longer listed by SendGrid, so this resource is not refreshed and cannot be imported.
+
+For more detailed information, please see the [SendGrid documentation](https://www.twilio.com/docs/sendgrid/ui/account-and-settings/teammates).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the access request.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"request_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the access request to decide.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"decision": schema.StringAttribute{
+				MarkdownDescription: "Whether to `approve` or `deny` the access request.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(accessRequestApprove, accessRequestDeny),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_scope_groups": schema.SetAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: `The names of the groups of scopes that can be approved, such as ` + "`Mail Send`" + `. An access request for any other group is denied, even when ` + "`decision`" + ` is ` + "`approve`" + `.
+
+When omitted, every access request is decided as ` + "`decision`" + ` says.`,
+				Optional: true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope_group_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group of scopes the teammate requested access to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username of the teammate who requested access.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the teammate who requested access.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"approved": schema.BoolAttribute{
+				MarkdownDescription: "Whether the access request was approved. It is `false` when the request was denied, including when its group of scopes is not in `allowed_scope_groups`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *teammateAccessRequestDecisionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan looks up the access request to be decided, so that the plan
+// shows who requested which group of scopes and whether it is approved.
+func (r *teammateAccessRequestDecisionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to look up when destroying, or when the decision is already made.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	if r.client == nil {
+		return
+	}
+
+	var plan teammateAccessRequestDecisionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.RequestID.IsUnknown() || plan.Decision.IsUnknown() || plan.AllowedScopeGroups.IsUnknown() {
+		return
+	}
+
+	a, diags := r.openAccessRequest(ctx, plan.RequestID.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(a.ID, 10))
+	plan.ScopeGroupName = types.StringValue(a.ScopeGroupName)
+	plan.Username = types.StringValue(a.Username)
+	plan.Email = types.StringValue(a.Email)
+	plan.Approved = types.BoolValue(approvesAccessRequest(ctx, plan, a))
+	if plan.Decision.ValueString() == accessRequestApprove && !plan.Approved.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("allowed_scope_groups"),
+			"Access request will be denied",
+			fmt.Sprintf("%s requested access to %q, which is not in allowed_scope_groups, so the request will be denied.", a.Email, a.ScopeGroupName),
+		)
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *teammateAccessRequestDecisionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data teammateAccessRequestDecisionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a, diags := r.openAccessRequest(ctx, data.RequestID.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	approved := approvesAccessRequest(ctx, data, a)
+	if approved {
+		if err := approveAccessRequest(ctx, r.client, a.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Approving teammate access request",
+				fmt.Sprintf("Unable to approve access request (id: %d), got error: %s", a.ID, err),
+			)
+			return
+		}
+	} else {
+		if err := denyAccessRequest(ctx, r.client, a.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Denying teammate access request",
+				fmt.Sprintf("Unable to deny access request (id: %d), got error: %s", a.ID, err),
+			)
+			return
+		}
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(a.ID, 10))
+	data.ScopeGroupName = types.StringValue(a.ScopeGroupName)
+	data.Username = types.StringValue(a.Username)
+	data.Email = types.StringValue(a.Email)
+	data.Approved = types.BoolValue(approved)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *teammateAccessRequestDecisionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A decided access request is no longer listed by SendGrid, so there is
+	// nothing to refresh; the state keeps the decision as it was made.
+}
+
+func (r *teammateAccessRequestDecisionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes have RequiresReplace, so this should never
+	// be called. Terraform will perform Delete + Create when they change.
+}
+
+func (r *teammateAccessRequestDecisionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A decision cannot be undone, so it is only removed from the state.
+}
+
+// openAccessRequest returns the open access request with the given ID, failing
+// when it has already been decided or does not exist.
+func (r *teammateAccessRequestDecisionResource) openAccessRequest(ctx context.Context, id int64) (*accessRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	a, err := getAccessRequest(ctx, r.client, id)
+	if err != nil {
+		diags.AddError(
+			"Reading teammate access request",
+			fmt.Sprintf("Unable to read access request (id: %d), got error: %s", id, err),
+		)
+		return nil, diags
+	}
+	if a == nil {
+		diags.AddAttributeError(
+			path.Root("request_id"),
+			"Access request not found",
+			fmt.Sprintf("There is no open access request with ID %d. It may have been decided already, or withdrawn.", id),
+		)
+		return nil, diags
+	}
+	return a, diags
+}
+
+// approvesAccessRequest reports whether the decision approves the access
+// request, which it only does for groups of scopes allowed by the policy.
+func approvesAccessRequest(ctx context.Context, data teammateAccessRequestDecisionResourceModel, a *accessRequest) bool {
+	if data.Decision.ValueString() != accessRequestApprove {
+		return false
+	}
+	if data.AllowedScopeGroups.IsNull() {
+		return true
+	}
+	return slices.Contains(flex.ExpandFrameworkStringSet(ctx, data.AllowedScopeGroups), a.ScopeGroupName)
+}
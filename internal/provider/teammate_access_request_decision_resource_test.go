@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/terraform-provider-sendgrid/internal/sendgridtest"
+)
+
+// testAccAccessRequest makes a new restricted teammate request access to the
+// scope group named scopeGroupName, and returns the ID of the request and the
+// username and email address of the teammate.
+func testAccAccessRequest(t *testing.T, server *sendgridtest.Server, scopeGroupName string) (id int64, username, email string) {
+	t.Helper()
+
+	ctx := context.Background()
+	client := sendgrid.New("SG.offline", sendgrid.OptionBaseURL(server.BaseURL()))
+
+	username = fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	email = username + "@example.com"
+	if _, err := client.InviteTeammate(ctx, &sendgrid.InputInviteTeammate{
+		Email:  email,
+		Scopes: []string{"user.profile.read"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.AcceptInvitation(email, username); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = client.DeleteTeammate(ctx, username)
+	})
+
+	id, err := server.RequestAccess(username, scopeGroupName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id, username, email
+}
+
+// testAccCheckTeammateHasScope checks whether the teammate named username has
+// been granted scope.
+func testAccCheckTeammateHasScope(server *sendgridtest.Server, username, scope string, want bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := sendgrid.New("SG.offline", sendgrid.OptionBaseURL(server.BaseURL()))
+		o, err := client.GetTeammate(context.Background(), username)
+		if err != nil {
+			return err
+		}
+		if got := slices.Contains(o.Scopes, scope); got != want {
+			return fmt.Errorf("expected teammate %s to have scope %s: %t, got scopes %v", username, scope, want, o.Scopes)
+		}
+		return nil
+	}
+}
+
+func TestAccTeammateAccessRequestDecisionResource_approve(t *testing.T) {
+	server := testAccOffline(t)
+
+	id, username, email := testAccAccessRequest(t, server, "Mail Send")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeammateAccessRequestDecisionResourceConfig(id, "approve", nil),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("sendgrid_teammate_access_request_decision.test", tfjsonpath.New("email"), knownvalue.StringExact(email)),
+						plancheck.ExpectKnownValue("sendgrid_teammate_access_request_decision.test", tfjsonpath.New("approved"), knownvalue.Bool(true)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate_access_request_decision.test", "id", strconv.FormatInt(id, 10)),
+					resource.TestCheckResourceAttr("sendgrid_teammate_access_request_decision.test", "scope_group_name", "Mail Send"),
+					resource.TestCheckResourceAttr("sendgrid_teammate_access_request_decision.test", "username", username),
+					resource.TestCheckResourceAttr("sendgrid_teammate_access_request_decision.test", "approved", "true"),
+					testAccCheckTeammateHasScope(server, username, "mail.send", true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTeammateAccessRequestDecisionResource_deny(t *testing.T) {
+	server := testAccOffline(t)
+
+	id, username, _ := testAccAccessRequest(t, server, "Billing")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeammateAccessRequestDecisionResourceConfig(id, "deny", nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate_access_request_decision.test", "scope_group_name", "Billing"),
+					resource.TestCheckResourceAttr("sendgrid_teammate_access_request_decision.test", "approved", "false"),
+					testAccCheckTeammateHasScope(server, username, "billing.read", false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTeammateAccessRequestDecisionResource_allowedScopeGroups(t *testing.T) {
+	server := testAccOffline(t)
+
+	id, username, _ := testAccAccessRequest(t, server, "Billing")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Billing is outside the policy, so approving denies it.
+			{
+				Config: testAccTeammateAccessRequestDecisionResourceConfig(id, "approve", []string{"Mail Send", "Stats"}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("sendgrid_teammate_access_request_decision.test", tfjsonpath.New("approved"), knownvalue.Bool(false)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate_access_request_decision.test", "decision", "approve"),
+					resource.TestCheckResourceAttr("sendgrid_teammate_access_request_decision.test", "approved", "false"),
+					testAccCheckTeammateHasScope(server, username, "billing.read", false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTeammateAccessRequestDecisionResource_notFound(t *testing.T) {
+	testAccOffline(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTeammateAccessRequestDecisionResourceConfig(0, "approve", nil),
+				ExpectError: regexp.MustCompile(`There is no open access request with ID 0`),
+			},
+		},
+	})
+}
+
+func testAccTeammateAccessRequestDecisionResourceConfig(id int64, decision string, allowedScopeGroups []string) string {
+	allowed := ""
+	if allowedScopeGroups != nil {
+		allowed = fmt.Sprintf("allowed_scope_groups = [%s]", strings.Join(escapesStrings(allowedScopeGroups), ", "))
+	}
+	return fmt.Sprintf(`
+resource "sendgrid_teammate_access_request_decision" "test" {
+	request_id = %d
+	decision   = %q
+	%s
+}
+`, id, decision, allowed)
+}
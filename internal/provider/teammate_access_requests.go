@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+// The sendgrid client does not cover the access requests of teammates, so
+// they are listed and decided through its generic request helpers.
+
+// accessRequest is a request of a restricted teammate for access to a group of
+// scopes, such as "Mail Send" or "Stats".
+type accessRequest struct {
+	ID             int64  `json:"id"`
+	ScopeGroupName string `json:"scope_group_name"`
+	Username       string `json:"username"`
+	Email          string `json:"email"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+}
+
+// listAccessRequests pages through the open access requests until SendGrid
+// returns a short page.
+//
+// see: https://www.twilio.com/docs/sendgrid/api-reference/teammates/retrieve-all-pending-teammate-requests
+func listAccessRequests(ctx context.Context, client *sendgrid.Client) ([]accessRequest, error) {
+	var items []accessRequest
+	for offset := 0; ; {
+		req, err := client.NewRequest("GET", fmt.Sprintf("/scopes/requests?limit=%d&offset=%d", accessRequestListPageSize, offset), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []accessRequest
+		if err := client.Do(ctx, req, &page); err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if len(page) < accessRequestListPageSize {
+			return items, nil
+		}
+		offset += len(page)
+	}
+}
+
+// getAccessRequest returns the open access request with the given ID, or nil
+// when there is none, as decided requests are no longer listed.
+func getAccessRequest(ctx context.Context, client *sendgrid.Client, id int64) (*accessRequest, error) {
+	requests, err := listAccessRequests(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range requests {
+		if a.ID == id {
+			return &a, nil
+		}
+	}
+	return nil, nil
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/teammates/approve-access-request
+func approveAccessRequest(ctx context.Context, client *sendgrid.Client, id int64) error {
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("/scopes/requests/%d/approve", id), nil)
+	if err != nil {
+		return err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/teammates/deny-access-request
+func denyAccessRequest(ctx context.Context, client *sendgrid.Client, id int64) error {
+	req, err := client.NewRequest("DELETE", fmt.Sprintf("/scopes/requests/%d", id), nil)
+	if err != nil {
+		return err
+	}
+
+	return client.Do(ctx, req, nil)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &teammateAccessRequestsDataSource{}
+	_ datasource.DataSourceWithConfigure = &teammateAccessRequestsDataSource{}
+)
+
+func newTeammateAccessRequestsDataSource() datasource.DataSource {
+	return &teammateAccessRequestsDataSource{}
+}
+
+type teammateAccessRequestsDataSource struct {
+	client *sendgrid.Client
+}
+
+type teammateAccessRequestsDataSourceModel struct {
+	EmailRegex     types.String                  `tfsdk:"email_regex"`
+	AccessRequests []teammateAccessRequestsModel `tfsdk:"access_requests"`
+}
+
+type teammateAccessRequestsModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	ScopeGroupName types.String `tfsdk:"scope_group_name"`
+	Username       types.String `tfsdk:"username"`
+	Email          types.String `tfsdk:"email"`
+	FirstName      types.String `tfsdk:"first_name"`
+	LastName       types.String `tfsdk:"last_name"`
+}
+
+func (d *teammateAccessRequestsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teammate_access_requests"
+}
+
+func (d *teammateAccessRequestsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *teammateAccessRequestsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the open access requests of your SendGrid account. Restricted teammates request access to a group of scopes, such as "Mail Send" or "Stats", which admins approve or deny.
+
+Requests can be decided with the ` + "`sendgrid_teammate_access_request_decision`" + ` resource. Decided requests are no longer listed.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"email_regex": regexFilterAttribute("access requests", "teammate email address"),
+			"access_requests": schema.ListNestedAttribute{
+				MarkdownDescription: "The open access requests matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the access request.",
+							Computed:            true,
+						},
+						"scope_group_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the group of scopes the teammate requests access to.",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The username of the teammate.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the teammate.",
+							Computed:            true,
+						},
+						"first_name": schema.StringAttribute{
+							MarkdownDescription: "The first name of the teammate.",
+							Computed:            true,
+						},
+						"last_name": schema.StringAttribute{
+							MarkdownDescription: "The last name of the teammate.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *teammateAccessRequestsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data teammateAccessRequestsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emailRegex, err := newRegexFilter(data.EmailRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading teammate access requests",
+			fmt.Sprintf("Unable to compile email_regex, got error: %s", err),
+		)
+		return
+	}
+
+	requests, err := listAccessRequests(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading teammate access requests",
+			fmt.Sprintf("Unable to get teammate access requests, got error: %s", err),
+		)
+		return
+	}
+
+	data.AccessRequests = []teammateAccessRequestsModel{}
+	for _, a := range requests {
+		if !emailRegex.match(a.Email) {
+			continue
+		}
+		data.AccessRequests = append(data.AccessRequests, teammateAccessRequestsModel{
+			ID:             types.Int64Value(a.ID),
+			ScopeGroupName: types.StringValue(a.ScopeGroupName),
+			Username:       types.StringValue(a.Username),
+			Email:          types.StringValue(a.Email),
+			FirstName:      types.StringValue(a.FirstName),
+			LastName:       types.StringValue(a.LastName),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
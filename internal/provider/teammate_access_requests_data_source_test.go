@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeammateAccessRequestsDataSource(t *testing.T) {
+	server := testAccOffline(t)
+
+	id, username, email := testAccAccessRequest(t, server, "Stats")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeammateAccessRequestsDataSourceConfig(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_access_requests.test", "access_requests.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_access_requests.test", "access_requests.0.id", strconv.FormatInt(id, 10)),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_access_requests.test", "access_requests.0.scope_group_name", "Stats"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_access_requests.test", "access_requests.0.username", username),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_access_requests.test", "access_requests.0.email", email),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate_access_requests.none", "access_requests.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeammateAccessRequestsDataSourceConfig(email string) string {
+	return fmt.Sprintf(`
+data "sendgrid_teammate_access_requests" "test" {
+	email_regex = "^%[1]s$"
+}
+
+data "sendgrid_teammate_access_requests" "none" {
+	email_regex = "^not-%[1]s$"
+}
+`, email)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResourceWithConfigure = &teammateListResource{}
+
+func newTeammateListResource() list.ListResource {
+	return &teammateListResource{}
+}
+
+type teammateListResource struct {
+	teammateResource
+}
+
+type teammateListResourceModel struct {
+	EmailRegex types.String `tfsdk:"email_regex"`
+	UserType   types.String `tfsdk:"user_type"`
+}
+
+func (r *teammateListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the teammates of your SendGrid account, including the ones who have not accepted their invitation yet. The account owner is not a teammate that can be managed, so it is never listed.",
+		Attributes: map[string]listschema.Attribute{
+			"email_regex": listRegexFilterAttribute("teammates", "email address"),
+			"user_type": listschema.StringAttribute{
+				MarkdownDescription: "Only list the teammates of this type, `admin` or `teammate`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf("admin", "teammate"),
+				},
+			},
+		},
+	}
+}
+
+func (r *teammateListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data teammateListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	emailRegex, err := newRegexFilter(data.EmailRegex)
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing teammates",
+			fmt.Sprintf("Unable to compile email_regex, got error: %s", err),
+		)
+		return
+	}
+
+	match := func(email string, isAdmin bool) bool {
+		userType := "teammate"
+		if isAdmin {
+			userType = "admin"
+		}
+		return emailRegex.match(email) && (data.UserType.IsNull() || data.UserType.ValueString() == userType)
+	}
+
+	pending, err := r.client.GetPendingTeammates(ctx)
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing teammates",
+			fmt.Sprintf("Unable to get pending teammates, got error: %s", err),
+		)
+		return
+	}
+
+	items, err := listAllTeammates(ctx, r.client)
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing teammates",
+			fmt.Sprintf("Unable to list teammates, got error: %s", err),
+		)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		push = limitResults(req, push)
+		for _, v := range pending.PendingTeammates {
+			if !match(v.Email, v.IsAdmin) {
+				continue
+			}
+			m := teammateImportModelFrom(v.Email, "", v.IsAdmin, v.Scopes, v.ExpirationDate)
+			if !push(newListResult(ctx, req, v.Email, m.identity(), &m)) {
+				return
+			}
+		}
+
+		for _, v := range items {
+			if v.UserType == "owner" || !match(v.Email, v.IsAdmin) {
+				continue
+			}
+			m := teammateImportModelFrom(v.Email, v.Username, v.IsAdmin, nil, 0)
+			// The scopes of a teammate are only returned when it is read on
+			// its own, so it is only read when the query needs them.
+			if req.IncludeResource && !v.IsAdmin {
+				teammate, err := r.client.GetTeammate(ctx, v.Username)
+				if err != nil {
+					push(listErrorResult(
+						"Listing teammates",
+						fmt.Sprintf("Unable to read teammate (username: %s), got error: %s", v.Username, err),
+					))
+					return
+				}
+				m = teammateImportModelFrom(teammate.Email, teammate.Username, teammate.IsAdmin, teammate.Scopes, 0)
+			}
+			if !push(newListResult(ctx, req, v.Email, m.identity(), &m)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTeammateListResource(t *testing.T) {
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeammateResourceConfig(email, []string{"user.profile.read"}),
+			},
+			// ImportState by identity testing
+			{
+				ResourceName:    "sendgrid_teammate.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				Query:  true,
+				Config: testAccTeammateListResourceQuery(email),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("sendgrid_teammate.test", 1),
+					querycheck.ExpectIdentity("sendgrid_teammate.test", map[string]knownvalue.Check{
+						"email": knownvalue.StringExact(email),
+					}),
+					querycheck.ExpectResourceKnownValues("sendgrid_teammate.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(email)),
+						[]querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("is_admin"), KnownValue: knownvalue.Bool(false)},
+							{Path: tfjsonpath.New("scopes"), KnownValue: knownvalue.SetExact([]knownvalue.Check{
+								knownvalue.StringExact("user.profile.read"),
+							})},
+						},
+					),
+					querycheck.ExpectLength("sendgrid_teammate.admins", 0),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTeammateListResource_accepted(t *testing.T) {
+	server := testAccOffline(t)
+
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeammateResourceConfig(email, []string{"user.profile.read"}),
+			},
+			// The teammate accepts the invitation, so their scopes are read
+			// from the teammate rather than the invitation.
+			{
+				PreConfig: func() {
+					if err := server.AcceptInvitation(email, username); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Query:  true,
+				Config: testAccTeammateListResourceQuery(email),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("sendgrid_teammate.test", 1),
+					querycheck.ExpectResourceKnownValues("sendgrid_teammate.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(email)),
+						[]querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("username"), KnownValue: knownvalue.StringExact(username)},
+							{Path: tfjsonpath.New("scopes"), KnownValue: knownvalue.SetExact([]knownvalue.Check{
+								knownvalue.StringExact("user.profile.read"),
+							})},
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccTeammateListResourceQuery(email string) string {
+	return fmt.Sprintf(`
+list "sendgrid_teammate" "test" {
+	provider         = sendgrid
+	include_resource = true
+
+	config {
+		email_regex = %[1]q
+	}
+}
+
+list "sendgrid_teammate" "admins" {
+	provider = sendgrid
+
+	config {
+		email_regex = %[1]q
+		user_type   = "admin"
+	}
+}
+`, "^"+regexp.QuoteMeta(email)+"$")
+}
@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
 )
 
@@ -55,3 +58,25 @@ func getTeammateByEmail(ctx context.Context, client *sendgrid.Client, email stri
 
 	return nil, nil
 }
+
+// invitationExpiresAt returns the expiration date of an invitation in RFC 3339
+// format, or null when it is unknown.
+func invitationExpiresAt(expirationDate int) types.String {
+	if expirationDate == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Unix(int64(expirationDate), 0).UTC().Format(time.RFC3339))
+}
+
+// The sendgrid client does not cover resending invitations, so they are
+// resent through its generic request helpers.
+
+// see: https://www.twilio.com/docs/sendgrid/api-reference/teammates/resend-teammate-invite
+func resendTeammateInvitation(ctx context.Context, client *sendgrid.Client, token string) error {
+	req, err := client.NewRequest("POST", fmt.Sprintf("/teammates/pending/%s/resend", token), nil)
+	if err != nil {
+		return err
+	}
+
+	return client.Do(ctx, req, nil)
+}
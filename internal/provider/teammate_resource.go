@@ -9,10 +9,15 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
 	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
@@ -21,6 +26,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &teammateResource{}
 var _ resource.ResourceWithImportState = &teammateResource{}
+var _ resource.ResourceWithIdentity = &teammateResource{}
+var _ resource.ResourceWithModifyPlan = &teammateResource{}
 
 var autoScopes = []string{
 	"2fa_exempt",
@@ -30,6 +37,7 @@ var autoScopes = []string{
 }
 
 // Scopes that cannot be assigned when inviting a teammate but can be added after invitation acceptance.
+// They are deferred until the teammate accepts the invitation.
 var scopesBlockedDuringInvitation = []string{
 	"user.profile.update",
 	"user.password.update",
@@ -44,11 +52,25 @@ type teammateResource struct {
 }
 
 type teammateResourceModel struct {
-	ID       types.String   `tfsdk:"id"`
-	Email    types.String   `tfsdk:"email"`
-	IsAdmin  types.Bool     `tfsdk:"is_admin"`
-	Scopes   []types.String `tfsdk:"scopes"`
-	Username types.String   `tfsdk:"username"`
+	ID                      types.String   `tfsdk:"id"`
+	Email                   types.String   `tfsdk:"email"`
+	IsAdmin                 types.Bool     `tfsdk:"is_admin"`
+	Scopes                  []types.String `tfsdk:"scopes"`
+	Username                types.String   `tfsdk:"username"`
+	DeferredScopes          []types.String `tfsdk:"deferred_scopes"`
+	Pending                 types.Bool     `tfsdk:"pending"`
+	InvitationExpiresAt     types.String   `tfsdk:"invitation_expires_at"`
+	ResendInvitationTrigger types.String   `tfsdk:"resend_invitation_trigger"`
+}
+
+type teammateIdentityModel struct {
+	Email types.String `tfsdk:"email"`
+}
+
+func (m teammateResourceModel) identity() teammateIdentityModel {
+	return teammateIdentityModel{
+		Email: m.Email,
+	}
 }
 
 func (r *teammateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,8 +91,11 @@ For more detailed information, please see the [SendGrid documentation](https://d
 				Computed: true,
 			},
 			"email": schema.StringAttribute{
-				MarkdownDescription: "Teammate's email",
+				MarkdownDescription: "Teammate's email. Changing it invites a new teammate.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "Teammate's username. If the username you provide is already associated with an existing SendGrid account or teammate, the request will fail.",
@@ -91,17 +116,49 @@ For more detailed information, please see the [SendGrid documentation](https://d
 
 The following Scopes are set automatically by SendGrid, so they cannot be set manually:` + flex.QuoteAndJoin(autoScopes) + `. A teammate remains in a pending state until the invitation is accepted, during which scopes cannot be modified.
 
-**Important:** The following scopes cannot be assigned when inviting a teammate and will cause an error:` + flex.QuoteAndJoin(scopesBlockedDuringInvitation) + `
+Only the scopes the API key of the provider has can be granted, which are listed by the ` + "`sendgrid_scopes`" + ` data source. Other scopes fail the plan. To start from a persona such as ` + "`developer`" + ` or ` + "`marketer`" + `, use the ` + "`sendgrid_scope_preset`" + ` data source.
 
-These scopes can be added after the teammate accepts the invitation. To work around this limitation:
-1. First invite the teammate without these scopes
-2. After the teammate accepts the invitation, update their permissions to include these scopes
+SendGrid does not allow the following scopes when inviting a teammate:` + flex.QuoteAndJoin(scopesBlockedDuringInvitation) + `. The teammate is invited without them, and they are kept in ` + "`deferred_scopes`" + ` while the teammate is pending. Once the teammate accepts the invitation, the next plan shows them being granted, and the next apply grants them.
 
 Please note that SendGrid API behavior may change without notice.
 If you encounter any issues, feel free to report them via [issues](https://github.com/kenzo0107/terraform-provider-sendgrid/issues).
 `,
 				Required: true,
 			},
+			"deferred_scopes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The scopes of `scopes` that SendGrid does not allow when inviting a teammate, and which are granted on the first apply after the teammate accepts the invitation.",
+				Computed:            true,
+			},
+			"pending": schema.BoolAttribute{
+				MarkdownDescription: "Whether the teammate has not accepted the invitation yet.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitation_expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the invitation of a pending teammate expires, in RFC 3339 format. SendGrid invitations expire seven days after they are sent. Empty once the teammate accepts the invitation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resend_invitation_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value that resends the invitation when it changes, while the teammate is pending. Resending an invitation, including an expired one, extends it by seven days. It has no effect once the teammate accepts the invitation.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *teammateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"email": identityschema.StringAttribute{
+				Description:       "The email address of the teammate.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -125,6 +182,116 @@ func (r *teammateResource) Configure(ctx context.Context, req resource.Configure
 	r.client = client
 }
 
+func (r *teammateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(r.planDeferredScopes(ctx, req, resp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resending the invitation extends it.
+	if !req.Plan.Raw.IsNull() && !req.State.Raw.IsNull() {
+		var plan, state types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("resend_invitation_trigger"), &plan)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("resend_invitation_trigger"), &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !plan.Equal(state) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("invitation_expires_at"), types.StringUnknown())...)
+		}
+	}
+
+	if r.client == nil {
+		return
+	}
+
+	scopes, d := changedScopes(ctx, req)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, s := range scopes {
+		if slices.Contains(autoScopes, s) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("scopes"),
+				"Invalid scope",
+				fmt.Sprintf("scopes automatically by SendGrid and cannot be manually assigned: %s", strings.Join(autoScopes, ", ")),
+			)
+			return
+		}
+	}
+	resp.Diagnostics.Append(validateGrantableScopes(ctx, r.client, path.Root("scopes"), scopes)...)
+}
+
+// planDeferredScopes plans the scopes that are deferred until the teammate
+// accepts the invitation: the blocked ones of a teammate that is invited or
+// still pending, and none once it has accepted.
+func (r *teammateResource) planDeferredScopes(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	var scopes types.Set
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("scopes"), &scopes)...)
+	if diags.HasError() || scopes.IsUnknown() {
+		return diags
+	}
+	for _, e := range scopes.Elements() {
+		if e.IsUnknown() {
+			return diags
+		}
+	}
+
+	pending := types.BoolValue(true)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("pending"), &pending)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	deferred := []string{}
+	if pending.ValueBool() {
+		for _, s := range flex.ExpandFrameworkStringSet(ctx, scopes) {
+			if slices.Contains(scopesBlockedDuringInvitation, s) {
+				deferred = append(deferred, s)
+			}
+		}
+	}
+	deferredSet, d := types.SetValueFrom(ctx, types.StringType, deferred)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("deferred_scopes"), deferredSet)...)
+	return diags
+}
+
+// blockedScopes returns the scopes that cannot be assigned when inviting a
+// teammate.
+func blockedScopes(scopes []types.String) []types.String {
+	blocked := []types.String{}
+	for _, s := range scopes {
+		if slices.Contains(scopesBlockedDuringInvitation, s.ValueString()) {
+			blocked = append(blocked, s)
+		}
+	}
+	return blocked
+}
+
+// ungrantedScopes returns the deferred scopes that are not granted yet.
+func ungrantedScopes(deferred []types.String, granted []string) []types.String {
+	scopes := []types.String{}
+	for _, s := range deferred {
+		if !slices.Contains(granted, s.ValueString()) {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
 func (r *teammateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data teammateResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -142,6 +309,7 @@ func (r *teammateResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	var scopes []string
+	deferredScopes := []types.String{}
 	for _, s := range data.Scopes {
 		// If scopes automatically added by SendGrid is specified, the process should fail.
 		if slices.Contains(autoScopes, s.ValueString()) {
@@ -154,17 +322,10 @@ func (r *teammateResource) Create(ctx context.Context, req resource.CreateReques
 			)
 			return
 		}
-		// Check if this scope is blocked during invitation
+		// Scopes blocked during invitation are granted once the teammate accepts it.
 		if slices.Contains(scopesBlockedDuringInvitation, s.ValueString()) {
-			resp.Diagnostics.AddError(
-				"Creating teammate",
-				fmt.Sprintf(
-					"The scope '%s' cannot be assigned when inviting a teammate. These scopes can be added after the teammate accepts the invitation. Blocked scopes: %s",
-					s.ValueString(),
-					strings.Join(scopesBlockedDuringInvitation, ", "),
-				),
-			)
-			return
+			deferredScopes = append(deferredScopes, s)
+			continue
 		}
 
 		scopes = append(scopes, s.ValueString())
@@ -176,10 +337,7 @@ func (r *teammateResource) Create(ctx context.Context, req resource.CreateReques
 		Scopes:  scopes,
 	}
 
-	// NOTE: Re-execute after the re-executable time has elapsed when a rate limit occurs
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.InviteTeammate(context.TODO(), input)
-	})
+	inviteTeammate, err := r.client.InviteTeammate(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating teammate",
@@ -188,15 +346,6 @@ func (r *teammateResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	inviteTeammate, ok := res.(*sendgrid.OutputInviteTeammate)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating teammate",
-			"Failed to assert type *sendgrid.OutputInviteTeammate",
-		)
-		return
-	}
-
 	scopesSet := []types.String{}
 	if !inviteTeammate.IsAdmin {
 		for _, s := range inviteTeammate.Scopes {
@@ -209,13 +358,31 @@ func (r *teammateResource) Create(ctx context.Context, req resource.CreateReques
 
 	// pending user does not have an username.
 	data = teammateResourceModel{
-		ID:      types.StringValue(inviteTeammate.Email),
-		Email:   types.StringValue(inviteTeammate.Email),
-		IsAdmin: types.BoolValue(inviteTeammate.IsAdmin),
-		Scopes:  scopesSet,
+		ID:                      types.StringValue(inviteTeammate.Email),
+		Email:                   types.StringValue(inviteTeammate.Email),
+		IsAdmin:                 types.BoolValue(inviteTeammate.IsAdmin),
+		Scopes:                  append(scopesSet, deferredScopes...),
+		DeferredScopes:          deferredScopes,
+		Pending:                 types.BoolValue(true),
+		InvitationExpiresAt:     types.StringNull(),
+		ResendInvitationTrigger: data.ResendInvitationTrigger,
+	}
+
+	// The invitation does not tell when it expires, so it is read from the
+	// pending teammates. The teammate is saved even if that fails, as it has
+	// been invited already.
+	pendingTeammate, err := pendingTeammateByEmail(ctx, r.client, inviteTeammate.Email)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating teammate",
+			fmt.Sprintf("Unable to get pending teammates, got error: %s", err),
+		)
+	} else if pendingTeammate != nil {
+		data.InvitationExpiresAt = invitationExpiresAt(pendingTeammate.ExpirationDate)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -262,10 +429,17 @@ func (r *teammateResource) Read(ctx context.Context, req resource.ReadRequest, r
 			//       While there might be differences from the actual code,
 			//       not accommodating the above would hinder team member management, making it unavoidable.
 			IsAdmin: data.IsAdmin,
-			Scopes:  scopes,
+			// The deferred scopes are not part of the invitation, but are
+			// kept in scopes so that they do not show up as a diff.
+			Scopes:                  append(scopes, ungrantedScopes(data.DeferredScopes, pendingTeammate.Scopes)...),
+			DeferredScopes:          ungrantedScopes(data.DeferredScopes, pendingTeammate.Scopes),
+			Pending:                 types.BoolValue(true),
+			InvitationExpiresAt:     invitationExpiresAt(pendingTeammate.ExpirationDate),
+			ResendInvitationTrigger: data.ResendInvitationTrigger,
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 		return
 	}
 
@@ -306,15 +480,22 @@ func (r *teammateResource) Read(ctx context.Context, req resource.ReadRequest, r
 		}
 	}
 
+	// Once the teammate accepts the invitation, the deferred scopes are left
+	// out of scopes, so that the next plan shows them being granted.
 	data = teammateResourceModel{
-		ID:       types.StringValue(o.Email),
-		Email:    types.StringValue(o.Email),
-		IsAdmin:  types.BoolValue(o.IsAdmin),
-		Username: types.StringValue(o.Username),
-		Scopes:   scopes,
+		ID:                      types.StringValue(o.Email),
+		Email:                   types.StringValue(o.Email),
+		IsAdmin:                 types.BoolValue(o.IsAdmin),
+		Username:                types.StringValue(o.Username),
+		Scopes:                  scopes,
+		DeferredScopes:          ungrantedScopes(data.DeferredScopes, o.Scopes),
+		Pending:                 types.BoolValue(false),
+		InvitationExpiresAt:     types.StringNull(),
+		ResendInvitationTrigger: data.ResendInvitationTrigger,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -350,6 +531,29 @@ func (r *teammateResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// If the teammate is in a pending state, it is not possible to update the permissions.
 	if pendingTeammate != nil {
+		expirationDate := pendingTeammate.ExpirationDate
+		if !data.ResendInvitationTrigger.IsNull() && !data.ResendInvitationTrigger.Equal(state.ResendInvitationTrigger) {
+			if err := resendTeammateInvitation(ctx, r.client, pendingTeammate.Token); err != nil {
+				resp.Diagnostics.AddError(
+					"Updating teammate",
+					fmt.Sprintf("Unable to resend the invitation of pending teammate (%s), got error: %s", email, err),
+				)
+				return
+			}
+
+			resent, err := pendingTeammateByEmail(ctx, r.client, email)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Updating teammate",
+					fmt.Sprintf("Unable to get pending teammates, got error: %s", err),
+				)
+				return
+			}
+			if resent != nil {
+				expirationDate = resent.ExpirationDate
+			}
+		}
+
 		scopes := []types.String{}
 		if !data.IsAdmin.ValueBool() {
 			scopes = data.Scopes
@@ -364,10 +568,15 @@ func (r *teammateResource) Update(ctx context.Context, req resource.UpdateReques
 			//       For pending teammates, it update the is_admin value in the tfstate to prevent any discrepancies.
 			//       While there might be differences from the actual code,
 			//       not accommodating the above would hinder team member management, making it unavoidable.
-			IsAdmin: data.IsAdmin,
-			Scopes:  scopes,
+			IsAdmin:                 data.IsAdmin,
+			Scopes:                  scopes,
+			DeferredScopes:          blockedScopes(scopes),
+			Pending:                 types.BoolValue(true),
+			InvitationExpiresAt:     invitationExpiresAt(expirationDate),
+			ResendInvitationTrigger: data.ResendInvitationTrigger,
 		}
 		resp.Diagnostics.Append(resp.State.Set(ctx, &p)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, p.identity())...)
 		return
 	}
 
@@ -416,14 +625,19 @@ func (r *teammateResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Save updated data into Terraform state
 	data = teammateResourceModel{
-		ID:       types.StringValue(o.Email),
-		Email:    types.StringValue(o.Email),
-		IsAdmin:  types.BoolValue(o.IsAdmin),
-		Username: types.StringValue(o.Username),
-		Scopes:   scopesSet,
+		ID:                      types.StringValue(o.Email),
+		Email:                   types.StringValue(o.Email),
+		IsAdmin:                 types.BoolValue(o.IsAdmin),
+		Username:                types.StringValue(o.Username),
+		Scopes:                  scopesSet,
+		DeferredScopes:          []types.String{},
+		Pending:                 types.BoolValue(false),
+		InvitationExpiresAt:     types.StringNull(),
+		ResendInvitationTrigger: data.ResendInvitationTrigger,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -438,10 +652,8 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	email := data.Email.ValueString()
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		// Invited users are treated as pending users until they set up their profiles.
-		return pendingTeammateByEmail(ctx, r.client, email)
-	})
+	// Invited users are treated as pending users until they set up their profiles.
+	pendingUser, err := pendingTeammateByEmail(ctx, r.client, email)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting teammate",
@@ -450,19 +662,8 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	pendingUser, ok := res.(*sendgrid.PendingTeammate)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Deleting teammate",
-			"Failed to assert type *sendgrid.PendingTeammate",
-		)
-		return
-	}
-
 	if pendingUser != nil {
-		_, err = retryOnRateLimit(ctx, func() (interface{}, error) {
-			return nil, r.client.DeletePendingTeammate(ctx, pendingUser.Token)
-		})
+		err = r.client.DeletePendingTeammate(ctx, pendingUser.Token)
 		// If the teammate is in a pending state, execute the API to remove pending teammates.
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -473,9 +674,7 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	res, err = retryOnRateLimit(ctx, func() (interface{}, error) {
-		return getTeammateByEmail(ctx, r.client, email)
-	})
+	teammateByEmail, err := getTeammateByEmail(ctx, r.client, email)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting teammate",
@@ -484,15 +683,6 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	teammateByEmail, ok := res.(*sendgrid.Teammate)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Deleting teammate",
-			"Failed to assert type *sendgrid.Teammate",
-		)
-		return
-	}
-
 	if teammateByEmail == nil {
 		resp.Diagnostics.AddError(
 			"Deleting teammate",
@@ -501,9 +691,7 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	_, err = retryOnRateLimit(ctx, func() (interface{}, error) {
-		return nil, r.client.DeleteTeammate(ctx, teammateByEmail.Username)
-	})
+	err = r.client.DeleteTeammate(ctx, teammateByEmail.Username)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -519,11 +707,10 @@ func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *teammateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var data teammateResourceModel
-
-	email := req.ID
-
-	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
+	email := importIDOrIdentity(ctx, req, resp, "email")
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	pendingTeammate, err := pendingTeammateByEmail(ctx, r.client, email)
 	if err != nil {
@@ -536,23 +723,9 @@ func (r *teammateResource) ImportState(ctx context.Context, req resource.ImportS
 
 	// If the teammate is in a pending state, return their data.
 	if pendingTeammate != nil {
-		scopes := []types.String{}
-		if !pendingTeammate.IsAdmin {
-			for _, s := range pendingTeammate.Scopes {
-				if slices.Contains(autoScopes, s) {
-					continue
-				}
-				scopes = append(scopes, types.StringValue(s))
-			}
-		}
-		data = teammateResourceModel{
-			ID:      types.StringValue(email),
-			Email:   types.StringValue(email),
-			IsAdmin: types.BoolValue(pendingTeammate.IsAdmin),
-			Scopes:  scopes,
-		}
-
+		data := teammateImportModelFrom(email, "", pendingTeammate.IsAdmin, pendingTeammate.Scopes, pendingTeammate.ExpirationDate)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 		return
 	}
 
@@ -582,9 +755,21 @@ func (r *teammateResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
+	data := teammateImportModelFrom(teammate.Email, teammate.Username, teammate.IsAdmin, teammate.Scopes, 0)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// teammateImportModelFrom returns the resource model of an imported teammate.
+// username is empty for pending teammates, who have not chosen one yet, and
+// expirationDate is only set for them.
+func teammateImportModelFrom(email, username string, isAdmin bool, teammateScopes []string, expirationDate int) teammateResourceModel {
 	scopes := []types.String{}
-	if !teammate.IsAdmin {
-		for _, s := range teammate.Scopes {
+	if !isAdmin {
+		for _, s := range teammateScopes {
 			// Automatically assigned scopes in SendGrid are not managed.
 			if slices.Contains(autoScopes, s) {
 				continue
@@ -593,16 +778,17 @@ func (r *teammateResource) ImportState(ctx context.Context, req resource.ImportS
 		}
 	}
 
-	data = teammateResourceModel{
-		ID:       types.StringValue(teammate.Email),
-		Email:    types.StringValue(teammate.Email),
-		IsAdmin:  types.BoolValue(teammate.IsAdmin),
-		Username: types.StringValue(teammate.Username),
-		Scopes:   scopes,
+	data := teammateResourceModel{
+		ID:                  types.StringValue(email),
+		Email:               types.StringValue(email),
+		IsAdmin:             types.BoolValue(isAdmin),
+		Scopes:              scopes,
+		DeferredScopes:      []types.String{},
+		Pending:             types.BoolValue(username == ""),
+		InvitationExpiresAt: invitationExpiresAt(expirationDate),
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if username != "" {
+		data.Username = types.StringValue(username)
 	}
+	return data
 }
@@ -4,12 +4,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/kenzo0107/sendgrid"
 )
 
 func TestAccTeammateResource(t *testing.T) {
@@ -29,6 +37,8 @@ func TestAccTeammateResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "email", email),
 					resource.TestCheckResourceAttr(resourceName, "is_admin", "false"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "user.profile.read"),
+					resource.TestCheckResourceAttr(resourceName, "pending", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "invitation_expires_at"),
 				),
 			},
 			// ImportState testing
@@ -41,6 +51,206 @@ func TestAccTeammateResource(t *testing.T) {
 	})
 }
 
+func TestAccTeammateResource_disappears(t *testing.T) {
+	server := testAccOffline(t)
+
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+	config := testAccTeammateResourceConfig(email, []string{"user.profile.read"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// The invitation is revoked outside of Terraform, so the next
+			// plan must recreate it.
+			{
+				PreConfig: func() {
+					client := sendgrid.New("SG.offline", sendgrid.OptionBaseURL(server.BaseURL()))
+					p, err := pendingTeammateByEmail(context.Background(), client, email)
+					if err != nil || p == nil {
+						t.Fatalf("pending teammate %s not found: %v", email, err)
+					}
+					if err := client.DeletePendingTeammate(context.Background(), p.Token); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("sendgrid_teammate.test", "email", email),
+			},
+		},
+	})
+}
+
+func TestAccTeammateResource_ungrantableScope(t *testing.T) {
+	server := testAccOffline(t)
+
+	// The API key of the provider can only grant the scopes it has.
+	server.SetScopes("user.profile.read")
+	t.Cleanup(func() { server.SetScopes() })
+
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTeammateResourceConfig(email, []string{"user.profile.read", "templates.read"}),
+				ExpectError: regexp.MustCompile(`"templates\.read"\s+is\s+not\s+a\s+scope\s+the\s+API\s+key\s+of\s+the\s+provider\s+can\s+grant`),
+			},
+			{
+				Config:      testAccTeammateResourceConfig(email, []string{"user.profile.raed"}),
+				ExpectError: regexp.MustCompile(`Did\s+you\s+mean\s+"user\.profile\.read"\?`),
+			},
+			{
+				Config: testAccTeammateResourceConfig(email, []string{"user.profile.read"}),
+			},
+			// Scopes added later are checked before the teammate is updated.
+			{
+				Config:      testAccTeammateResourceConfig(email, []string{"user.profile.read", "templates.read"}),
+				ExpectError: regexp.MustCompile(`Invalid\s+scope`),
+			},
+		},
+	})
+}
+
+func TestAccTeammateResource_resendInvitation(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_teammate.test"
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	expiresAt := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeammateResourceConfigResend(email, "1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					expiresAt.AddStateValue(resourceName, tfjsonpath.New("invitation_expires_at")),
+				},
+			},
+			// The invitation expires before the teammate accepts it.
+			{
+				PreConfig: func() {
+					if err := server.ExpireInvitation(email); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccTeammateResourceConfigResend(email, "1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					expiresAt.AddStateValue(resourceName, tfjsonpath.New("invitation_expires_at")),
+					statecheck.ExpectKnownValue("data.sendgrid_pending_teammates.test", tfjsonpath.New("pending_teammates").AtSliceIndex(0).AtMapKey("expired"), knownvalue.Bool(true)),
+				},
+			},
+			// Changing the trigger resends it.
+			{
+				Config: testAccTeammateResourceConfigResend(email, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("invitation_expires_at")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					expiresAt.AddStateValue(resourceName, tfjsonpath.New("invitation_expires_at")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("pending"), knownvalue.Bool(true)),
+				},
+			},
+			// The teammate accepts the resent invitation.
+			{
+				PreConfig: func() {
+					if err := server.AcceptInvitation(email, username); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccTeammateResourceConfigResend(email, "2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("pending"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("invitation_expires_at"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("username"), knownvalue.StringExact(username)),
+				},
+			},
+		},
+	})
+}
+
+func testAccTeammateResourceConfigResend(email, trigger string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_teammate" "test" {
+	email                     = "%s"
+	scopes                    = ["user.profile.read"]
+	resend_invitation_trigger = "%s"
+}
+
+data "sendgrid_pending_teammates" "test" {
+	email_regex = "^${sendgrid_teammate.test.email}$"
+}
+`, email, trigger)
+}
+
+func TestAccTeammateResource_deferredScopes(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_teammate.test"
+	email := fmt.Sprintf("test-acc-%s@example.com", acctest.RandString(16))
+	username := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	scopes := knownvalue.SetExact([]knownvalue.Check{
+		knownvalue.StringExact("user.profile.read"),
+		knownvalue.StringExact("user.profile.update"),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The teammate is invited without the scope SendGrid refuses in
+			// invitations.
+			{
+				Config: testAccTeammateResourceConfig(email, []string{"user.profile.read", "user.profile.update"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("scopes"), scopes),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deferred_scopes"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("user.profile.update"),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("pending"), knownvalue.Bool(true)),
+				},
+			},
+			// Once the invitation is accepted, the plan shows the deferred
+			// scope being granted.
+			{
+				PreConfig: func() {
+					if err := server.AcceptInvitation(email, username); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccTeammateResourceConfig(email, []string{"user.profile.read", "user.profile.update"}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("deferred_scopes"), knownvalue.SetSizeExact(0)),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("scopes"), scopes),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deferred_scopes"), knownvalue.SetSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("pending"), knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
 func testAccTeammateResourceConfig(email string, scopes []string) string {
 	for i, s := range scopes {
 		scopes[i] = `"` + s + `"`
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &teammatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &teammatesDataSource{}
+)
+
+func newTeammatesDataSource() datasource.DataSource {
+	return &teammatesDataSource{}
+}
+
+type teammatesDataSource struct {
+	client *sendgrid.Client
+}
+
+type teammatesDataSourceModel struct {
+	EmailRegex types.String     `tfsdk:"email_regex"`
+	UserType   types.String     `tfsdk:"user_type"`
+	Teammates  []teammatesModel `tfsdk:"teammates"`
+}
+
+type teammatesModel struct {
+	Username  types.String `tfsdk:"username"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	UserType  types.String `tfsdk:"user_type"`
+	IsAdmin   types.Bool   `tfsdk:"is_admin"`
+}
+
+func (d *teammatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teammates"
+}
+
+func (d *teammatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *teammatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the teammates of your SendGrid account.
+
+Pending teammates, who have not accepted their invitation yet, are not listed.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"email_regex": regexFilterAttribute("teammates", "email address"),
+			"user_type": schema.StringAttribute{
+				MarkdownDescription: "Only list the teammates of this type, `owner`, `admin` or `teammate`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf("owner", "admin", "teammate"),
+				},
+			},
+			"teammates": schema.ListNestedAttribute{
+				MarkdownDescription: "The teammates matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The username of the teammate.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the teammate.",
+							Computed:            true,
+						},
+						"first_name": schema.StringAttribute{
+							MarkdownDescription: "The first name of the teammate.",
+							Computed:            true,
+						},
+						"last_name": schema.StringAttribute{
+							MarkdownDescription: "The last name of the teammate.",
+							Computed:            true,
+						},
+						"user_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the teammate, `owner`, `admin` or `teammate`.",
+							Computed:            true,
+						},
+						"is_admin": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if the teammate has admin permissions.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *teammatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data teammatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emailRegex, err := newRegexFilter(data.EmailRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading teammates",
+			fmt.Sprintf("Unable to compile email_regex, got error: %s", err),
+		)
+		return
+	}
+
+	items, err := listAllTeammates(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading teammates",
+			fmt.Sprintf("Unable to list teammates, got error: %s", err),
+		)
+		return
+	}
+
+	data.Teammates = []teammatesModel{}
+	for _, v := range items {
+		if !emailRegex.match(v.Email) {
+			continue
+		}
+		if !data.UserType.IsNull() && v.UserType != data.UserType.ValueString() {
+			continue
+		}
+		data.Teammates = append(data.Teammates, teammatesModel{
+			Username:  types.StringValue(v.Username),
+			Email:     types.StringValue(v.Email),
+			FirstName: types.StringValue(v.FirstName),
+			LastName:  types.StringValue(v.LastName),
+			UserType:  types.StringValue(v.UserType),
+			IsAdmin:   types.BoolValue(v.IsAdmin),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
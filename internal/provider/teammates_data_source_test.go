@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeammatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeammatesDataSourceConfig(acctest.RandString(16)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_teammates.owner", "teammates.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammates.owner", "teammates.0.user_type", "owner"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammates.owner", "teammates.0.is_admin", "true"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_teammates.owner", "teammates.0.username"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_teammates.owner", "teammates.0.email"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammates.none", "teammates.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeammatesDataSourceConfig(suffix string) string {
+	return `
+data "sendgrid_teammates" "owner" {
+	user_type = "owner"
+}
+
+data "sendgrid_teammates" "none" {
+	email_regex = "^test-acc-` + suffix + `@"
+}
+`
+}
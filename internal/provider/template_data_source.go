@@ -7,8 +7,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
 )
@@ -68,12 +71,20 @@ Transactional templates are templates created specifically for transactional ema
 		`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the transactional template.",
-				Required:            true,
+				MarkdownDescription: "The ID of the transactional template. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name for the transactional template.",
+				MarkdownDescription: "The name for the transactional template. Exactly one of `id` or `name` must be set; the lookup fails unless exactly one template has this name.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
 			},
 			"generation": schema.StringAttribute{
 				MarkdownDescription: "Defines the generation of the template.",
@@ -92,6 +103,32 @@ func (d *templateDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	id := s.ID.ValueString()
+	if !s.Name.IsNull() {
+		templates, err := listAllTemplates(ctx, d.client, "legacy,dynamic")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading template",
+				fmt.Sprintf("Unable to list templates, got error: %s", err),
+			)
+			return
+		}
+
+		var ids []string
+		for _, t := range templates {
+			if t.Name == s.Name.ValueString() {
+				ids = append(ids, t.ID)
+			}
+		}
+		id, err = singleIDByName("template", s.Name.ValueString(), ids)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading template",
+				fmt.Sprintf("Unable to look up template by name, got error: %s", err),
+			)
+			return
+		}
+	}
+
 	o, err := d.client.GetTemplate(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
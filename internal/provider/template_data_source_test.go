@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -45,3 +46,76 @@ data "sendgrid_template" "test" {
 }
 `, name)
 }
+
+func TestAccTemplateDataSource_name(t *testing.T) {
+	resourceName := "data.sendgrid_template.test"
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTemplateDataSourceConfigName(name, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "sendgrid_template.test.0", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Several templates have the name
+			{
+				Config:      testAccTemplateDataSourceConfigName(name, 2),
+				ExpectError: regexp.MustCompile(`2\s+templates\s+named\s+"` + name + `"\s+were\s+found`),
+			},
+			// No template has the name
+			{
+				Config:      testAccTemplateDataSourceConfigName(name, 0),
+				ExpectError: regexp.MustCompile(`no\s+template\s+named\s+"` + name + `"\s+was\s+found`),
+			},
+		},
+	})
+}
+
+func TestAccTemplateDataSource_idAndName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "sendgrid_template" "test" {
+	id   = "d-0123456789abcdef0123456789abcdef"
+	name = "test-acc"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+data "sendgrid_template" "test" {}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccTemplateDataSourceConfigName(name string, count int) string {
+	return fmt.Sprintf(`
+resource "sendgrid_template" "test" {
+	count = %[2]d
+	name       = "%[1]s"
+	generation = "dynamic"
+}
+
+data "sendgrid_template" "test" {
+	name = "%[1]s"
+
+	depends_on = [sendgrid_template.test]
+}
+`, name, count)
+}
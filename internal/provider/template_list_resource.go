@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResourceWithConfigure = &templateListResource{}
+
+func newTemplateListResource() list.ListResource {
+	return &templateListResource{}
+}
+
+type templateListResource struct {
+	templateResource
+}
+
+type templateListResourceModel struct {
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Generation types.String `tfsdk:"generation"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (r *templateListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the transactional templates of your SendGrid account.",
+		Attributes: map[string]listschema.Attribute{
+			"name_regex": listRegexFilterAttribute("templates", "name"),
+			"generation": listschema.StringAttribute{
+				MarkdownDescription: "Only list the templates of this generation, `legacy` or `dynamic`. Both are listed by default.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf("legacy", "dynamic"),
+				},
+			},
+			"on_behalf_of": listOnBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *templateListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data templateListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	nameRegex, err := newRegexFilter(data.NameRegex)
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing templates",
+			fmt.Sprintf("Unable to compile name_regex, got error: %s", err),
+		)
+		return
+	}
+
+	generations := "legacy,dynamic"
+	if !data.Generation.IsNull() {
+		generations = data.Generation.ValueString()
+	}
+
+	ctx = withOnBehalfOf(ctx, data.OnBehalfOf.ValueString())
+
+	items, err := listAllTemplates(ctx, r.client, generations)
+	if err != nil {
+		stream.Results = listErrorResults(
+			"Listing templates",
+			fmt.Sprintf("Unable to list templates, got error: %s", err),
+		)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		push = limitResults(req, push)
+		for _, v := range items {
+			if !nameRegex.match(v.Name) {
+				continue
+			}
+			m := templateResourceModel{
+				ID:         types.StringValue(v.ID),
+				Name:       types.StringValue(v.Name),
+				Generation: types.StringValue(v.Generation),
+				OnBehalfOf: data.OnBehalfOf,
+			}
+			if !push(newListResult(ctx, req, v.Name, m.identity(), &m)) {
+				return
+			}
+		}
+	}
+}
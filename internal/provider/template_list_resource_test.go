@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTemplateListResource(t *testing.T) {
+	prefix := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateListResourceConfig(prefix),
+			},
+			// ImportState by identity testing
+			{
+				ResourceName:    "sendgrid_template.dynamic",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				Query:  true,
+				Config: testAccTemplateListResourceQuery(prefix),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("sendgrid_template.test", 2),
+					querycheck.ExpectIdentity("sendgrid_template.test", map[string]knownvalue.Check{
+						"id":           knownvalue.NotNull(),
+						"on_behalf_of": knownvalue.Null(),
+					}),
+					querycheck.ExpectLength("sendgrid_template.legacy", 1),
+					querycheck.ExpectResourceKnownValues("sendgrid_template.legacy",
+						queryfilter.ByDisplayName(knownvalue.StringExact(prefix+"-legacy")),
+						[]querycheck.KnownValueCheck{
+							{Path: tfjsonpath.New("name"), KnownValue: knownvalue.StringExact(prefix + "-legacy")},
+							{Path: tfjsonpath.New("generation"), KnownValue: knownvalue.StringExact("legacy")},
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccTemplateListResourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_template" "dynamic" {
+	name       = "%[1]s-dynamic"
+	generation = "dynamic"
+}
+
+resource "sendgrid_template" "legacy" {
+	name       = "%[1]s-legacy"
+	generation = "legacy"
+}
+`, prefix)
+}
+
+func testAccTemplateListResourceQuery(prefix string) string {
+	return fmt.Sprintf(`
+list "sendgrid_template" "test" {
+	provider = sendgrid
+
+	config {
+		name_regex = "^%[1]s-"
+	}
+}
+
+list "sendgrid_template" "legacy" {
+	provider         = sendgrid
+	include_resource = true
+
+	config {
+		name_regex = "^%[1]s-"
+		generation = "legacy"
+	}
+}
+`, prefix)
+}
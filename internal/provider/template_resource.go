@@ -7,8 +7,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +20,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &templateResource{}
 var _ resource.ResourceWithImportState = &templateResource{}
+var _ resource.ResourceWithIdentity = &templateResource{}
 
 func newTemplateResource() resource.Resource {
 	return &templateResource{}
@@ -33,6 +34,19 @@ type templateResourceModel struct {
 	ID         types.String `tfsdk:"id"`
 	Name       types.String `tfsdk:"name"`
 	Generation types.String `tfsdk:"generation"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+type templateIdentityModel struct {
+	ID         types.String `tfsdk:"id"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (m templateResourceModel) identity() templateIdentityModel {
+	return templateIdentityModel{
+		ID:         m.ID,
+		OnBehalfOf: m.OnBehalfOf,
+	}
 }
 
 func (r *templateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,6 +83,19 @@ Transactional templates are templates created specifically for transactional ema
 					stringOneOf("legacy", "dynamic"),
 				},
 			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *templateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the transactional template.",
+				RequiredForImport: true,
+			},
+			"on_behalf_of": onBehalfOfIdentityAttribute(),
 		},
 	}
 }
@@ -99,11 +126,11 @@ func (r *templateResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateTemplate(ctx, &sendgrid.InputCreateTemplate{
-			Name:       plan.Name.ValueString(),
-			Generation: plan.Generation.ValueString(),
-		})
+	ctx = withOnBehalfOf(ctx, plan.OnBehalfOf.ValueString())
+
+	o, err := r.client.CreateTemplate(ctx, &sendgrid.InputCreateTemplate{
+		Name:       plan.Name.ValueString(),
+		Generation: plan.Generation.ValueString(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -113,21 +140,14 @@ func (r *templateResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateTemplate)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating template",
-			"Failed to assert type *sendgrid.OutputCreateTemplate",
-		)
-		return
-	}
-
 	plan = templateResourceModel{
 		ID:         types.StringValue(o.ID),
 		Name:       types.StringValue(o.Name),
 		Generation: types.StringValue(o.Generation),
+		OnBehalfOf: plan.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -140,6 +160,8 @@ func (r *templateResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	id := state.ID.ValueString()
 	o, err := r.client.GetTemplate(ctx, id)
 	if err != nil {
@@ -154,8 +176,10 @@ func (r *templateResource) Read(ctx context.Context, req resource.ReadRequest, r
 		ID:         types.StringValue(o.ID),
 		Name:       types.StringValue(o.Name),
 		Generation: types.StringValue(o.Generation),
+		OnBehalfOf: state.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -169,6 +193,8 @@ func (r *templateResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	id := state.ID.ValueString()
 	o, err := r.client.UpdateTemplate(ctx, id, &sendgrid.InputUpdateTemplate{
 		Name: data.Name.ValueString(),
@@ -185,8 +211,10 @@ func (r *templateResource) Update(ctx context.Context, req resource.UpdateReques
 		ID:         state.ID,
 		Name:       types.StringValue(o.Name),
 		Generation: types.StringValue(o.Generation),
+		OnBehalfOf: state.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -199,6 +227,8 @@ func (r *templateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	id := state.ID.ValueString()
 	if err := r.client.DeleteTemplate(ctx, id); err != nil {
 		resp.Diagnostics.AddError(
@@ -212,8 +242,11 @@ func (r *templateResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *templateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data templateResourceModel
 
-	id := req.ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	onBehalfOf, id := importOnBehalfOfID(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withOnBehalfOf(ctx, onBehalfOf)
 
 	o, err := r.client.GetTemplate(ctx, id)
 	if err != nil {
@@ -229,7 +262,11 @@ func (r *templateResource) ImportState(ctx context.Context, req resource.ImportS
 		Name:       types.StringValue(o.Name),
 		Generation: types.StringValue(o.Generation),
 	}
+	if onBehalfOf != "" {
+		data.OnBehalfOf = types.StringValue(onBehalfOf)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
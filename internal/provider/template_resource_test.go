@@ -5,10 +5,12 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccTemplateResource(t *testing.T) {
@@ -55,3 +57,54 @@ resource "sendgrid_template" "test" {
 }
 `, name)
 }
+
+func TestAccTemplateResource_onBehalfOf(t *testing.T) {
+	server := testAccOffline(t)
+
+	resourceName := "sendgrid_template.test"
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	subuser := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTemplateResourceConfigOnBehalfOf(name, subuser),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "on_behalf_of", subuser),
+					func(s *terraform.State) error {
+						for _, r := range server.Requests() {
+							if r.Method == http.MethodPost && r.Path == "/v3/templates" && r.OnBehalfOf == subuser {
+								return nil
+							}
+						}
+						return fmt.Errorf("template was not created on behalf of %s", subuser)
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return subuser + "/" + s.RootModule().Resources[resourceName].Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+func testAccTemplateResourceConfigOnBehalfOf(name, subuser string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_template" "test" {
+	name         = "%s"
+	generation   = "dynamic"
+	on_behalf_of = "%s"
+}
+`, name, subuser)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &templateSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &templateSettingsDataSource{}
+)
+
+func newTemplateSettingsDataSource() datasource.DataSource {
+	return &templateSettingsDataSource{}
+}
+
+type templateSettingsDataSource struct {
+	client *sendgrid.Client
+}
+
+type templateSettingsDataSourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	HTMLContent types.String `tfsdk:"html_content"`
+}
+
+func (d *templateSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_settings"
+}
+
+func (d *templateSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *templateSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Retrieve the legacy email template mail setting of your SendGrid account.
+
+The Template setting wraps an HTML template around your email content. This legacy template is not the same as the dynamic and legacy transactional templates managed by ` + "`sendgrid_template`" + `.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the legacy email template mail setting is enabled.",
+				Computed:            true,
+			},
+			"html_content": schema.StringAttribute{
+				MarkdownDescription: "The HTML content of the template. It must contain the `<% body %>` tag, which is replaced with the content of your emails.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *templateSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state templateSettingsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getMailSetting[mailSettingTemplate](ctx, d.client, "template")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading legacy email template settings",
+			fmt.Sprintf("Unable to get legacy email template settings, got error: %s", err),
+		)
+		return
+	}
+
+	u := templateSettingsDataSourceModel{
+		Enabled:     types.BoolValue(o.Enabled),
+		HTMLContent: types.StringValue(o.HTMLContent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &u)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
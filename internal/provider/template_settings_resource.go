@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &templateSettingsResource{}
+var _ resource.ResourceWithImportState = &templateSettingsResource{}
+
+func newTemplateSettingsResource() resource.Resource {
+	return &templateSettingsResource{}
+}
+
+type templateSettingsResource struct {
+	client *sendgrid.Client
+}
+
+type templateSettingsResourceModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	HTMLContent types.String `tfsdk:"html_content"`
+}
+
+func (r *templateSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_settings"
+}
+
+func (r *templateSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the legacy email template mail setting of your SendGrid account.
+
+The Template setting wraps an HTML template around your email content. This legacy template is not the same as the dynamic and legacy transactional templates managed by ` + "`sendgrid_template`" + `.
+
+Destroying this resource disables the setting and clears its content.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the legacy email template mail setting is enabled.",
+				Required:            true,
+			},
+			"html_content": schema.StringAttribute{
+				MarkdownDescription: "The HTML content of the template. It must contain the `<% body %>` tag, which is replaced with the content of your emails.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (r *templateSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *templateSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan templateSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := updateMailSetting(ctx, r.client, "template", &mailSettingTemplate{
+		Enabled:     plan.Enabled.ValueBool(),
+		HTMLContent: plan.HTMLContent.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating legacy email template settings",
+			fmt.Sprintf("Unable to update legacy email template settings, got error: %s", err),
+		)
+		return
+	}
+
+	plan = templateSettingsResourceModel{
+		Enabled:     types.BoolValue(o.Enabled),
+		HTMLContent: types.StringValue(o.HTMLContent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *templateSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state templateSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := getMailSetting[mailSettingTemplate](ctx, r.client, "template")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading legacy email template settings",
+			fmt.Sprintf("Unable to read legacy email template settings, got error: %s", err),
+		)
+		return
+	}
+
+	state = templateSettingsResourceModel{
+		Enabled:     types.BoolValue(o.Enabled),
+		HTMLContent: types.StringValue(o.HTMLContent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *templateSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data templateSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := updateMailSetting(ctx, r.client, "template", &mailSettingTemplate{
+		Enabled:     data.Enabled.ValueBool(),
+		HTMLContent: data.HTMLContent.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating legacy email template settings",
+			fmt.Sprintf("Unable to update legacy email template settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = templateSettingsResourceModel{
+		Enabled:     types.BoolValue(o.Enabled),
+		HTMLContent: types.StringValue(o.HTMLContent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *templateSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state templateSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mail settings cannot be deleted, so reset the setting to its default.
+	_, err := updateMailSetting(ctx, r.client, "template", &mailSettingTemplate{
+		Enabled:     false,
+		HTMLContent: "",
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting legacy email template settings",
+			fmt.Sprintf("Unable to reset legacy email template settings, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *templateSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data templateSettingsResourceModel
+
+	o, err := getMailSetting[mailSettingTemplate](ctx, r.client, "template")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing legacy email template settings",
+			fmt.Sprintf("Unable to read legacy email template settings, got error: %s", err),
+		)
+		return
+	}
+
+	data = templateSettingsResourceModel{
+		Enabled:     types.BoolValue(o.Enabled),
+		HTMLContent: types.StringValue(o.HTMLContent),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTemplateSettingsResource(t *testing.T) {
+	resourceName := "sendgrid_template_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTemplateSettingsResourceConfig(true, "<html><body><% body %></body></html>"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "html_content", "<html><body><% body %></body></html>"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importBounceSettingsStateIdFunc(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "html_content",
+			},
+			// Update and Read testing
+			{
+				Config: testAccTemplateSettingsResourceConfig(false, "<html><body><div><% body %></div></body></html>"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "html_content", "<html><body><div><% body %></div></body></html>"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTemplateSettingsResourceConfig(enabled bool, htmlContent string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_template_settings" "test" {
+  enabled      = %t
+  html_content = %q
+}`, enabled, htmlContent)
+}
@@ -9,8 +9,8 @@ import (
 	"math/big"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,6 +24,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &templateVersionResource{}
 var _ resource.ResourceWithImportState = &templateVersionResource{}
+var _ resource.ResourceWithIdentity = &templateVersionResource{}
 
 func newTemplateVersionResource() resource.Resource {
 	return &templateVersionResource{}
@@ -45,6 +46,21 @@ type templateVersionResourceModel struct {
 	Editor               types.String `tfsdk:"editor"`
 	TestData             types.String `tfsdk:"test_data"`
 	ThumbnailURL         types.String `tfsdk:"thumbnail_url"`
+	OnBehalfOf           types.String `tfsdk:"on_behalf_of"`
+}
+
+type templateVersionIdentityModel struct {
+	TemplateID types.String `tfsdk:"template_id"`
+	ID         types.String `tfsdk:"id"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (m templateVersionResourceModel) identity() templateVersionIdentityModel {
+	return templateVersionIdentityModel{
+		TemplateID: m.TemplateID,
+		ID:         m.ID,
+		OnBehalfOf: m.OnBehalfOf,
+	}
 }
 
 func (r *templateVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -125,6 +141,23 @@ For more information about transactional templates, please see our Transactional
 				MarkdownDescription: "A Thumbnail preview of the template's html content.",
 				Computed:            true,
 			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *templateVersionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"template_id": identityschema.StringAttribute{
+				Description:       "The ID of the template.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the template version.",
+				RequiredForImport: true,
+			},
+			"on_behalf_of": onBehalfOfIdentityAttribute(),
 		},
 	}
 }
@@ -175,9 +208,9 @@ func (r *templateVersionResource) Create(ctx context.Context, req resource.Creat
 		input.PlainContent = plan.PlainContent.ValueString()
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateTemplateVersion(ctx, templateID, input)
-	})
+	ctx = withOnBehalfOf(ctx, plan.OnBehalfOf.ValueString())
+
+	o, err := r.client.CreateTemplateVersion(ctx, templateID, input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating template version",
@@ -186,15 +219,6 @@ func (r *templateVersionResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateTemplateVersion)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating template version",
-			"Failed to assert type *sendgrid.OutputCreateTemplateVersion",
-		)
-		return
-	}
-
 	plan = templateVersionResourceModel{
 		ID:                   types.StringValue(o.ID),
 		TemplateID:           types.StringValue(o.TemplateID),
@@ -207,8 +231,10 @@ func (r *templateVersionResource) Create(ctx context.Context, req resource.Creat
 		Editor:               types.StringValue(o.Editor),
 		TestData:             types.StringValue(o.TestData),
 		ThumbnailURL:         types.StringValue(o.ThumbnailURL),
+		OnBehalfOf:           plan.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -221,6 +247,8 @@ func (r *templateVersionResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	versionID := state.ID.ValueString()
 	templateID := state.TemplateID.ValueString()
 	o, err := r.client.GetTemplateVersion(ctx, templateID, versionID)
@@ -244,8 +272,10 @@ func (r *templateVersionResource) Read(ctx context.Context, req resource.ReadReq
 		Editor:               types.StringValue(o.Editor),
 		TestData:             types.StringValue(o.TestData),
 		ThumbnailURL:         types.StringValue(o.ThumbnailURL),
+		OnBehalfOf:           state.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -285,6 +315,8 @@ func (r *templateVersionResource) Update(ctx context.Context, req resource.Updat
 		input.TestData = data.TestData.ValueString()
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	versionID := state.ID.ValueString()
 	templateID := data.TemplateID.ValueString()
 
@@ -309,8 +341,10 @@ func (r *templateVersionResource) Update(ctx context.Context, req resource.Updat
 		Editor:               types.StringValue(o.Editor),
 		TestData:             types.StringValue(o.TestData),
 		ThumbnailURL:         types.StringValue(o.ThumbnailURL),
+		OnBehalfOf:           state.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -323,6 +357,8 @@ func (r *templateVersionResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	versionID := state.ID.ValueString()
 	templateID := state.TemplateID.ValueString()
 	if err := r.client.DeleteTemplateVersion(ctx, templateID, versionID); err != nil {
@@ -337,20 +373,33 @@ func (r *templateVersionResource) Delete(ctx context.Context, req resource.Delet
 func (r *templateVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data templateVersionResourceModel
 
-	id := req.ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-
-	// id = templateID/versionID
-	a := strings.Split(id, "/")
-	if len(a) != 2 {
-		resp.Diagnostics.AddError(
-			"Importing template version",
-			"Unable to import template version, id must be in the format of templateID/versionID",
-		)
-		return
+	var onBehalfOf, templateID, versionID string
+	if req.ID != "" {
+		// id = templateID/versionID or subuser/templateID/versionID
+		a := strings.Split(req.ID, "/")
+		if len(a) == 3 {
+			onBehalfOf, a = a[0], a[1:]
+		}
+		if len(a) != 2 {
+			resp.Diagnostics.AddError(
+				"Importing template version",
+				"Unable to import template version, id must be in the format of templateID/versionID or subuser/templateID/versionID",
+			)
+			return
+		}
+		templateID, versionID = a[0], a[1]
+	} else {
+		var identity templateVersionIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		onBehalfOf = identity.OnBehalfOf.ValueString()
+		templateID = identity.TemplateID.ValueString()
+		versionID = identity.ID.ValueString()
 	}
-	templateID := a[0]
-	versionID := a[1]
+
+	ctx = withOnBehalfOf(ctx, onBehalfOf)
 
 	o, err := r.client.GetTemplateVersion(ctx, templateID, versionID)
 	if err != nil {
@@ -374,7 +423,11 @@ func (r *templateVersionResource) ImportState(ctx context.Context, req resource.
 		TestData:             types.StringValue(o.TestData),
 		ThumbnailURL:         types.StringValue(o.ThumbnailURL),
 	}
+	if onBehalfOf != "" {
+		data.OnBehalfOf = types.StringValue(onBehalfOf)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
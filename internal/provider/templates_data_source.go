@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &templatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &templatesDataSource{}
+)
+
+func newTemplatesDataSource() datasource.DataSource {
+	return &templatesDataSource{}
+}
+
+type templatesDataSource struct {
+	client *sendgrid.Client
+}
+
+type templatesDataSourceModel struct {
+	NameRegex  types.String     `tfsdk:"name_regex"`
+	Generation types.String     `tfsdk:"generation"`
+	OnBehalfOf types.String     `tfsdk:"on_behalf_of"`
+	Templates  []templatesModel `tfsdk:"templates"`
+}
+
+type templatesModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Generation types.String `tfsdk:"generation"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+func (d *templatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_templates"
+}
+
+func (d *templatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *templatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the transactional templates of your SendGrid account.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex": regexFilterAttribute("templates", "name"),
+			"generation": schema.StringAttribute{
+				MarkdownDescription: "Only list the templates of this generation, `legacy` or `dynamic`. Both are listed by default.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf("legacy", "dynamic"),
+				},
+			},
+			"on_behalf_of": onBehalfOfDataSourceAttribute(),
+			"templates": schema.ListNestedAttribute{
+				MarkdownDescription: "The templates matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the template.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the template.",
+							Computed:            true,
+						},
+						"generation": schema.StringAttribute{
+							MarkdownDescription: "The generation of the template, `legacy` or `dynamic`.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "The date and time the template was last updated.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *templatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data templatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, err := newRegexFilter(data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading templates",
+			fmt.Sprintf("Unable to compile name_regex, got error: %s", err),
+		)
+		return
+	}
+
+	generations := "legacy,dynamic"
+	if !data.Generation.IsNull() {
+		generations = data.Generation.ValueString()
+	}
+
+	ctx = withOnBehalfOf(ctx, data.OnBehalfOf.ValueString())
+
+	items, err := listAllTemplates(ctx, d.client, generations)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading templates",
+			fmt.Sprintf("Unable to list templates, got error: %s", err),
+		)
+		return
+	}
+
+	data.Templates = []templatesModel{}
+	for _, v := range items {
+		if !nameRegex.match(v.Name) {
+			continue
+		}
+		data.Templates = append(data.Templates, templatesModel{
+			ID:         types.StringValue(v.ID),
+			Name:       types.StringValue(v.Name),
+			Generation: types.StringValue(v.Generation),
+			UpdatedAt:  types.StringValue(v.UpdatedAt),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTemplatesDataSource(t *testing.T) {
+	prefix := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTemplatesDataSourceConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_templates.all", "templates.#", "2"),
+					resource.TestCheckResourceAttr("data.sendgrid_templates.dynamic", "templates.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_templates.dynamic", "templates.0.id", "sendgrid_template.dynamic", "id"),
+					resource.TestCheckResourceAttr("data.sendgrid_templates.dynamic", "templates.0.name", prefix+"-dynamic"),
+					resource.TestCheckResourceAttr("data.sendgrid_templates.dynamic", "templates.0.generation", "dynamic"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_templates.dynamic", "templates.0.updated_at"),
+					resource.TestCheckResourceAttr("data.sendgrid_templates.none", "templates.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTemplatesDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_template" "dynamic" {
+	name       = "%[1]s-dynamic"
+	generation = "dynamic"
+}
+
+resource "sendgrid_template" "legacy" {
+	name       = "%[1]s-legacy"
+	generation = "legacy"
+}
+
+data "sendgrid_templates" "all" {
+	name_regex = "^%[1]s-"
+
+	depends_on = [sendgrid_template.dynamic, sendgrid_template.legacy]
+}
+
+data "sendgrid_templates" "dynamic" {
+	name_regex = "^%[1]s-"
+	generation = "dynamic"
+
+	depends_on = [sendgrid_template.dynamic, sendgrid_template.legacy]
+}
+
+data "sendgrid_templates" "none" {
+	name_regex = "^%[1]s-none$"
+
+	depends_on = [sendgrid_template.dynamic, sendgrid_template.legacy]
+}
+`, prefix)
+}
+
+func TestAccTemplatesDataSource_onBehalfOf(t *testing.T) {
+	server := testAccOffline(t)
+
+	subuser := fmt.Sprintf("test-acc-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: fmt.Sprintf(`
+data "sendgrid_templates" "test" {
+	on_behalf_of = "%s"
+}
+`, subuser),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_templates.test", "on_behalf_of", subuser),
+					func(s *terraform.State) error {
+						for _, r := range server.Requests() {
+							if r.Method == http.MethodGet && r.Path == "/v3/templates" && r.OnBehalfOf == subuser {
+								return nil
+							}
+						}
+						return fmt.Errorf("templates were not listed on behalf of %s", subuser)
+					},
+				),
+			},
+		},
+	})
+}
@@ -8,8 +8,11 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kenzo0107/sendgrid"
 )
@@ -68,12 +71,20 @@ Visit the main documentation to [learn more about suppression/unsubscribe groups
 		`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the unsubscribe group.",
-				Required:            true,
+				MarkdownDescription: "The ID of the unsubscribe group. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of your suppression group.",
+				MarkdownDescription: "The name of your suppression group. Exactly one of `id` or `name` must be set; the lookup fails unless exactly one unsubscribe group has this name.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "A brief description of your suppression group.",
@@ -96,6 +107,32 @@ func (d *unsubscribeGroupDataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	groupID := s.ID.ValueString()
+	if !s.Name.IsNull() {
+		groups, err := d.client.GetSuppressionGroups(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading unsubscribe group",
+				fmt.Sprintf("Unable to list unsubscribe groups, got error: %s", err),
+			)
+			return
+		}
+
+		var ids []string
+		for _, g := range groups {
+			if g.Name == s.Name.ValueString() {
+				ids = append(ids, strconv.FormatInt(g.ID, 10))
+			}
+		}
+		groupID, err = singleIDByName("unsubscribe group", s.Name.ValueString(), ids)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Reading unsubscribe group",
+				fmt.Sprintf("Unable to look up unsubscribe group by name, got error: %s", err),
+			)
+			return
+		}
+	}
+
 	id, _ := strconv.ParseInt(groupID, 10, 64)
 	o, err := d.client.GetSuppressionGroup(ctx, id)
 	if err != nil {
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -48,3 +49,73 @@ data "sendgrid_unsubscribe_group" "test" {
 }
 `, name, description, is_default)
 }
+
+// SendGrid rejects unsubscribe groups with duplicate names, so only the
+// lookup of a missing name can fail.
+func TestAccUnsubscribeGroupDataSource_name(t *testing.T) {
+	resourceName := "data.sendgrid_unsubscribe_group.test"
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUnsubscribeGroupDataSourceConfigName(name, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "sendgrid_unsubscribe_group.test.0", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// No unsubscribe group has the name
+			{
+				Config:      testAccUnsubscribeGroupDataSourceConfigName(name, 0),
+				ExpectError: regexp.MustCompile(`no\s+unsubscribe\s+group\s+named\s+"` + name + `"\s+was\s+found`),
+			},
+		},
+	})
+}
+
+func TestAccUnsubscribeGroupDataSource_idAndName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "sendgrid_unsubscribe_group" "test" {
+	id   = "1"
+	name = "test-acc"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+data "sendgrid_unsubscribe_group" "test" {}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccUnsubscribeGroupDataSourceConfigName(name string, count int) string {
+	return fmt.Sprintf(`
+resource "sendgrid_unsubscribe_group" "test" {
+	count = %[2]d
+	name        = "%[1]s"
+	description = "test-acc"
+}
+
+data "sendgrid_unsubscribe_group" "test" {
+	name = "%[1]s"
+
+	depends_on = [sendgrid_unsubscribe_group.test]
+}
+`, name, count)
+}
@@ -8,8 +8,8 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +19,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &unsubscribeGroupResource{}
 var _ resource.ResourceWithImportState = &unsubscribeGroupResource{}
+var _ resource.ResourceWithIdentity = &unsubscribeGroupResource{}
 
 func newUnsubscribeGroupResource() resource.Resource {
 	return &unsubscribeGroupResource{}
@@ -33,6 +34,19 @@ type unsubscribeGroupResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	IsDefault   types.Bool   `tfsdk:"is_default"`
+	OnBehalfOf  types.String `tfsdk:"on_behalf_of"`
+}
+
+type unsubscribeGroupIdentityModel struct {
+	ID         types.String `tfsdk:"id"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (m unsubscribeGroupResourceModel) identity() unsubscribeGroupIdentityModel {
+	return unsubscribeGroupIdentityModel{
+		ID:         m.ID,
+		OnBehalfOf: m.OnBehalfOf,
+	}
 }
 
 func (r *unsubscribeGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -67,6 +81,19 @@ Visit the main documentation to [learn more about suppression/unsubscribe groups
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *unsubscribeGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the unsubscribe group.",
+				RequiredForImport: true,
+			},
+			"on_behalf_of": onBehalfOfIdentityAttribute(),
 		},
 	}
 }
@@ -97,12 +124,12 @@ func (r *unsubscribeGroupResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	res, err := retryOnRateLimit(ctx, func() (interface{}, error) {
-		return r.client.CreateSuppressionGroup(ctx, &sendgrid.InputCreateSuppressionGroup{
-			Name:        plan.Name.ValueString(),
-			Description: plan.Description.ValueString(),
-			IsDefault:   plan.IsDefault.ValueBool(),
-		})
+	ctx = withOnBehalfOf(ctx, plan.OnBehalfOf.ValueString())
+
+	o, err := r.client.CreateSuppressionGroup(ctx, &sendgrid.InputCreateSuppressionGroup{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		IsDefault:   plan.IsDefault.ValueBool(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -112,22 +139,15 @@ func (r *unsubscribeGroupResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	o, ok := res.(*sendgrid.OutputCreateSuppressionGroup)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Creating unsubscribe group",
-			"Failed to assert type *sendgrid.OutputCreateSuppressionGroup",
-		)
-		return
-	}
-
 	plan = unsubscribeGroupResourceModel{
 		ID:          types.StringValue(strconv.FormatInt(o.ID, 10)),
 		Name:        types.StringValue(o.Name),
 		Description: types.StringValue(o.Description),
 		IsDefault:   types.BoolValue(o.IsDefault),
+		OnBehalfOf:  plan.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -140,6 +160,8 @@ func (r *unsubscribeGroupResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	groupID := state.ID.ValueString()
 	id, _ := strconv.ParseInt(groupID, 10, 64)
 
@@ -157,8 +179,10 @@ func (r *unsubscribeGroupResource) Read(ctx context.Context, req resource.ReadRe
 		Name:        types.StringValue(o.Name),
 		Description: types.StringValue(o.Description),
 		IsDefault:   types.BoolValue(o.IsDefault),
+		OnBehalfOf:  state.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -172,6 +196,8 @@ func (r *unsubscribeGroupResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	groupID := state.ID.ValueString()
 	id, _ := strconv.ParseInt(groupID, 10, 64)
 
@@ -193,8 +219,10 @@ func (r *unsubscribeGroupResource) Update(ctx context.Context, req resource.Upda
 		Name:        types.StringValue(o.Name),
 		Description: types.StringValue(o.Description),
 		IsDefault:   types.BoolValue(o.IsDefault),
+		OnBehalfOf:  state.OnBehalfOf,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -207,6 +235,8 @@ func (r *unsubscribeGroupResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
 	groupID := state.ID.ValueString()
 	id, _ := strconv.ParseInt(groupID, 10, 64)
 
@@ -222,8 +252,11 @@ func (r *unsubscribeGroupResource) Delete(ctx context.Context, req resource.Dele
 func (r *unsubscribeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data unsubscribeGroupResourceModel
 
-	groupID := req.ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	onBehalfOf, groupID := importOnBehalfOfID(ctx, req, resp, "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withOnBehalfOf(ctx, onBehalfOf)
 
 	id, _ := strconv.ParseInt(groupID, 10, 64)
 
@@ -242,7 +275,11 @@ func (r *unsubscribeGroupResource) ImportState(ctx context.Context, req resource
 		Description: types.StringValue(o.Description),
 		IsDefault:   types.BoolValue(o.IsDefault),
 	}
+	if onBehalfOf != "" {
+		data.OnBehalfOf = types.StringValue(onBehalfOf)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/terraform-provider-sendgrid/flex"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &unsubscribeGroupSuppressionsResource{}
+var _ resource.ResourceWithImportState = &unsubscribeGroupSuppressionsResource{}
+var _ resource.ResourceWithIdentity = &unsubscribeGroupSuppressionsResource{}
+
+func newUnsubscribeGroupSuppressionsResource() resource.Resource {
+	return &unsubscribeGroupSuppressionsResource{}
+}
+
+type unsubscribeGroupSuppressionsResource struct {
+	client *sendgrid.Client
+}
+
+type unsubscribeGroupSuppressionsResourceModel struct {
+	GroupID    types.String `tfsdk:"group_id"`
+	Emails     types.Set    `tfsdk:"emails"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+type unsubscribeGroupSuppressionsIdentityModel struct {
+	GroupID    types.String `tfsdk:"group_id"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (m unsubscribeGroupSuppressionsResourceModel) identity() unsubscribeGroupSuppressionsIdentityModel {
+	return unsubscribeGroupSuppressionsIdentityModel{
+		GroupID:    m.GroupID,
+		OnBehalfOf: m.OnBehalfOf,
+	}
+}
+
+func (r *unsubscribeGroupSuppressionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unsubscribe_group_suppressions"
+}
+
+func (r *unsubscribeGroupSuppressionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the suppressions of an unsubscribe group.
+
+Suppressed addresses no longer receive the emails sent with the unsubscribe group. Use this resource for addresses that must always be suppressed, such as do-not-contact lists.
+
+The resource only manages the addresses listed in ` + "`emails`" + `. Recipients who unsubscribe from the group themselves are left untouched. Destroying this resource removes the listed addresses from the group.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the unsubscribe group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"emails": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses to suppress in the unsubscribe group.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *unsubscribeGroupSuppressionsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_id": identityschema.StringAttribute{
+				Description:       "The ID of the unsubscribe group.",
+				RequiredForImport: true,
+			},
+			"on_behalf_of": onBehalfOfIdentityAttribute(),
+		},
+	}
+}
+
+func (r *unsubscribeGroupSuppressionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *unsubscribeGroupSuppressionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan unsubscribeGroupSuppressionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, plan.OnBehalfOf.ValueString())
+
+	groupID := plan.GroupID.ValueString()
+	id, err := strconv.ParseInt(groupID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating unsubscribe group suppressions",
+			fmt.Sprintf("Unable to parse unsubscribe group ID (id: %s), got error: %s", groupID, err),
+		)
+		return
+	}
+
+	if _, err := r.client.AddSuppressionsToGroup(ctx, id, &sendgrid.InputAddSuppressionsToGroup{
+		RecipientEmails: flex.ExpandFrameworkStringSet(ctx, plan.Emails),
+	}); err != nil {
+		resp.Diagnostics.AddError(
+			"Creating unsubscribe group suppressions",
+			fmt.Sprintf("Unable to add suppressions to unsubscribe group (id: %s), got error: %s", groupID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *unsubscribeGroupSuppressionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state unsubscribeGroupSuppressionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
+	groupID := state.GroupID.ValueString()
+
+	// Only look up the managed addresses, so that recipients who unsubscribed
+	// themselves do not show up as drift.
+	emails, err := r.client.SearchForSuppressionsWithinGroup(ctx, groupID, &sendgrid.InputSearchGroupSuppressions{
+		RecipientEmails: flex.ExpandFrameworkStringSet(ctx, state.Emails),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading unsubscribe group suppressions",
+			fmt.Sprintf("Unable to read suppressions of unsubscribe group (id: %s), got error: %s", groupID, err),
+		)
+		return
+	}
+
+	if len(emails) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, emails)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Emails = set
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *unsubscribeGroupSuppressionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state unsubscribeGroupSuppressionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
+	groupID := state.GroupID.ValueString()
+	id, err := strconv.ParseInt(groupID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating unsubscribe group suppressions",
+			fmt.Sprintf("Unable to parse unsubscribe group ID (id: %s), got error: %s", groupID, err),
+		)
+		return
+	}
+
+	added, removed, err := flex.DiffStringSet(ctx, state.Emails, plan.Emails)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating unsubscribe group suppressions",
+			fmt.Sprintf("Unable to compare suppressions of unsubscribe group (id: %s), got error: %s", groupID, err),
+		)
+		return
+	}
+
+	if len(added) > 0 {
+		if _, err := r.client.AddSuppressionsToGroup(ctx, id, &sendgrid.InputAddSuppressionsToGroup{
+			RecipientEmails: added,
+		}); err != nil {
+			resp.Diagnostics.AddError(
+				"Updating unsubscribe group suppressions",
+				fmt.Sprintf("Unable to add suppressions to unsubscribe group (id: %s), got error: %s", groupID, err),
+			)
+			return
+		}
+	}
+
+	for _, email := range removed {
+		if err := r.client.DeleteSuppressionFromGroup(ctx, groupID, email); err != nil {
+			resp.Diagnostics.AddError(
+				"Updating unsubscribe group suppressions",
+				fmt.Sprintf("Unable to remove %s from unsubscribe group (id: %s), got error: %s", email, groupID, err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *unsubscribeGroupSuppressionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state unsubscribeGroupSuppressionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, state.OnBehalfOf.ValueString())
+
+	groupID := state.GroupID.ValueString()
+	for _, email := range flex.ExpandFrameworkStringSet(ctx, state.Emails) {
+		if err := r.client.DeleteSuppressionFromGroup(ctx, groupID, email); err != nil {
+			resp.Diagnostics.AddError(
+				"Deleting unsubscribe group suppressions",
+				fmt.Sprintf("Unable to remove %s from unsubscribe group (id: %s), got error: %s", email, groupID, err),
+			)
+			return
+		}
+	}
+}
+
+// ImportState imports every address currently suppressed in the group. The
+// import ID is the group ID, optionally prefixed with a subuser.
+func (r *unsubscribeGroupSuppressionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	onBehalfOf, groupID := importOnBehalfOfID(ctx, req, resp, "group_id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withOnBehalfOf(ctx, onBehalfOf)
+
+	emails, err := r.client.GetSuppressionsForSuppressionGroup(ctx, groupID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing unsubscribe group suppressions",
+			fmt.Sprintf("Unable to read suppressions of unsubscribe group (id: %s), got error: %s", groupID, err),
+		)
+		return
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, emails)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := unsubscribeGroupSuppressionsResourceModel{
+		GroupID: types.StringValue(groupID),
+		Emails:  set,
+	}
+	if onBehalfOf != "" {
+		data.OnBehalfOf = types.StringValue(onBehalfOf)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
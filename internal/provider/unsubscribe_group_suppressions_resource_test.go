@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccUnsubscribeGroupSuppressionsResource(t *testing.T) {
+	resourceName := "sendgrid_unsubscribe_group_suppressions.test"
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUnsubscribeGroupSuppressionsResourceConfig(name, `["legal@example.com", "dnc@example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "sendgrid_unsubscribe_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "emails.*", "legal@example.com"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "emails.*", "dnc@example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    importUnsubscribeGroupSuppressionsStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group_id",
+			},
+			// Update and Read testing
+			{
+				Config: testAccUnsubscribeGroupSuppressionsResourceConfig(name, `["dnc@example.com", "abuse@example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "emails.*", "dnc@example.com"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "emails.*", "abuse@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUnsubscribeGroupSuppressionsResourceConfig(name, emails string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_unsubscribe_group" "test" {
+  name        = %q
+  description = "Suppressions acceptance test"
+}
+
+resource "sendgrid_unsubscribe_group_suppressions" "test" {
+  group_id = sendgrid_unsubscribe_group.test.id
+  emails   = %s
+}
+`, name, emails)
+}
+
+func importUnsubscribeGroupSuppressionsStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["group_id"], nil
+	}
+}
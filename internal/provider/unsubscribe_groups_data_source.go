@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kenzo0107/sendgrid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &unsubscribeGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &unsubscribeGroupsDataSource{}
+)
+
+func newUnsubscribeGroupsDataSource() datasource.DataSource {
+	return &unsubscribeGroupsDataSource{}
+}
+
+type unsubscribeGroupsDataSource struct {
+	client *sendgrid.Client
+}
+
+type unsubscribeGroupsDataSourceModel struct {
+	NameRegex         types.String             `tfsdk:"name_regex"`
+	OnBehalfOf        types.String             `tfsdk:"on_behalf_of"`
+	UnsubscribeGroups []unsubscribeGroupsModel `tfsdk:"unsubscribe_groups"`
+}
+
+type unsubscribeGroupsModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	IsDefault    types.Bool   `tfsdk:"is_default"`
+	Unsubscribes types.Int64  `tfsdk:"unsubscribes"`
+}
+
+func (d *unsubscribeGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unsubscribe_groups"
+}
+
+func (d *unsubscribeGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *unsubscribeGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Provides the unsubscribe groups of your SendGrid account.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex":   regexFilterAttribute("unsubscribe groups", "name"),
+			"on_behalf_of": onBehalfOfDataSourceAttribute(),
+			"unsubscribe_groups": schema.ListNestedAttribute{
+				MarkdownDescription: "The unsubscribe groups matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the unsubscribe group.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the unsubscribe group.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the unsubscribe group.",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if this is the default unsubscribe group.",
+							Computed:            true,
+						},
+						"unsubscribes": schema.Int64Attribute{
+							MarkdownDescription: "The number of addresses unsubscribed from the group.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *unsubscribeGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data unsubscribeGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, err := newRegexFilter(data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading unsubscribe groups",
+			fmt.Sprintf("Unable to compile name_regex, got error: %s", err),
+		)
+		return
+	}
+
+	ctx = withOnBehalfOf(ctx, data.OnBehalfOf.ValueString())
+
+	// SendGrid returns every unsubscribe group in a single page.
+	groups, err := d.client.GetSuppressionGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading unsubscribe groups",
+			fmt.Sprintf("Unable to list unsubscribe groups, got error: %s", err),
+		)
+		return
+	}
+
+	data.UnsubscribeGroups = []unsubscribeGroupsModel{}
+	for _, v := range groups {
+		if !nameRegex.match(v.Name) {
+			continue
+		}
+		data.UnsubscribeGroups = append(data.UnsubscribeGroups, unsubscribeGroupsModel{
+			ID:           types.StringValue(strconv.FormatInt(v.ID, 10)),
+			Name:         types.StringValue(v.Name),
+			Description:  types.StringValue(v.Description),
+			IsDefault:    types.BoolValue(v.IsDefault),
+			Unsubscribes: types.Int64Value(v.Unsubscribes),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUnsubscribeGroupsDataSource(t *testing.T) {
+	resourceName := "data.sendgrid_unsubscribe_groups.test"
+
+	name := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+	description := fmt.Sprintf("test-acc-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUnsubscribeGroupsDataSourceConfig(name, description),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "unsubscribe_groups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "unsubscribe_groups.0.id", "sendgrid_unsubscribe_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "unsubscribe_groups.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "unsubscribe_groups.0.description", description),
+					resource.TestCheckResourceAttr(resourceName, "unsubscribe_groups.0.is_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "unsubscribe_groups.0.unsubscribes", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUnsubscribeGroupsDataSourceConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_unsubscribe_group" "test" {
+	name        = "%s"
+	description = "%s"
+}
+
+data "sendgrid_unsubscribe_groups" "test" {
+	name_regex = "^${sendgrid_unsubscribe_group.test.name}$"
+}
+`, name, description)
+}
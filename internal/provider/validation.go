@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultValidationTimeout      = "10m"
+	defaultValidationPollInterval = "15s"
+)
+
+// validationFailure is a DNS record that SendGrid could not validate.
+type validationFailure struct {
+	record string
+	reason string
+}
+
+// validateFunc asks SendGrid to validate the DNS records of a resource once.
+type validateFunc func(ctx context.Context) (valid bool, failures []validationFailure, err error)
+
+// waitForValidation triggers validate every interval until it passes or
+// timeout elapses. It returns the failures of the last attempt, which are
+// empty once validation passed.
+func waitForValidation(ctx context.Context, interval, timeout time.Duration, validate validateFunc) ([]validationFailure, error) {
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 1; ; attempt++ {
+		valid, failures, err := validate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if valid {
+			return nil, nil
+		}
+
+		tflog.Info(ctx, "DNS records are not valid yet", map[string]any{
+			"attempt":  attempt,
+			"failures": formatValidationFailures(failures),
+		})
+
+		if time.Now().Add(interval).After(deadline) {
+			// SendGrid does not return results for every record type,
+			// e.g. the MX record of a domain without automatic security.
+			if len(failures) == 0 {
+				failures = []validationFailure{{record: "unknown", reason: "SendGrid did not report which record is failing."}}
+			}
+			return failures, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func formatValidationFailures(failures []validationFailure) string {
+	lines := make([]string, 0, len(failures))
+	for _, f := range failures {
+		lines = append(lines, fmt.Sprintf("  - %s: %s", f.record, f.reason))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// createValidationDiagnostics turns the errors of a validation that ran on
+// create into warnings. The DNS records of a new resource are usually built
+// from its own attributes, so they cannot be published before the apply that
+// creates it returns: failing would taint the resource, and the next apply
+// would replace it with one needing different records. Instead, the next plan
+// validates it again. what describes the resource, e.g. "the domain", and
+// validationResource names the resource that waits within one apply.
+func createValidationDiagnostics(diags diag.Diagnostics, what, validationResource string) diag.Diagnostics {
+	var out diag.Diagnostics
+	for _, d := range diags {
+		if d.Severity() != diag.SeverityError {
+			out.Append(d)
+			continue
+		}
+		out.AddWarning(
+			d.Summary(),
+			fmt.Sprintf("%s\n\nThe next plan validates %s again. To wait for its DNS records within one apply, use the %s resource.", d.Detail(), what, validationResource),
+		)
+	}
+	return out
+}
+
+// validationAttributes are the schema attributes of resources that can wait
+// for their DNS records to be validated. what describes the resource, e.g.
+// "the domain", and validationResource names the resource that waits within
+// one apply.
+func validationAttributes(what, validationResource string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"wait_for_validation": schema.BoolAttribute{
+			MarkdownDescription: fmt.Sprintf("Whether to ask SendGrid to validate the DNS records of %[1]s on create and update, and to wait until they are valid. Once `validation_timeout` expires, an update fails with the records that are still failing. On create, they are only reported as a warning, as the DNS records of %[1]s cannot be published before the apply that creates it returns. While %[1]s is not valid, every plan updates this resource to validate it again. To wait for the DNS records within the apply that creates them, use the `%[2]s` resource instead. (default: false)", what, validationResource),
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"validation_timeout": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("How long to wait for the DNS records of %s to be valid when `wait_for_validation` is set. (default: `%s`)", what, defaultValidationTimeout),
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(defaultValidationTimeout),
+			Validators: []validator.String{
+				stringDuration(),
+			},
+		},
+		"validation_poll_interval": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("How long to wait between validation attempts when `wait_for_validation` is set. (default: `%s`)", defaultValidationPollInterval),
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(defaultValidationPollInterval),
+			Validators: []validator.String{
+				stringDuration(),
+			},
+		},
+	}
+}
+
+// planRevalidation plans unknown values for the computed attributes that
+// validation refreshes while the prior state is not valid and
+// wait_for_validation is set, so that the next apply validates the resource
+// again. It is meant to be called from ModifyPlan.
+func planRevalidation(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, computed ...path.Path) {
+	// Nothing to validate again on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var valid, wait types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("valid"), &valid)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("wait_for_validation"), &wait)...)
+	if resp.Diagnostics.HasError() || valid.ValueBool() || !wait.ValueBool() {
+		return
+	}
+
+	for _, p := range append([]path.Path{path.Root("valid")}, computed...) {
+		a, diags := resp.Plan.Schema.AttributeAtPath(ctx, p)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		t := a.GetType()
+		unknown, err := t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), tftypes.UnknownValue))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(p, "Planning validation", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, p, unknown)...)
+	}
+}
+
+// validationSettings returns the timeout and poll interval configured by
+// validationAttributes. Both have been checked by stringDuration.
+func validationSettings(timeout, pollInterval types.String) (time.Duration, time.Duration) {
+	t, _ := time.ParseDuration(timeout.ValueString())
+	i, _ := time.ParseDuration(pollInterval.ValueString())
+	return t, i
+}
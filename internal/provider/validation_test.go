@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForValidation(t *testing.T) {
+	t.Parallel()
+
+	failing := []validationFailure{{record: "mail_cname", reason: "not found"}}
+	errValidate := errors.New("boom")
+
+	tests := map[string]struct {
+		// validUntil is the attempt from which validation passes, or 0 if
+		// it never does.
+		validUntil   int
+		err          error
+		timeout      time.Duration
+		wantFailures []validationFailure
+		wantErr      error
+		// wantAttempts is not checked when 0, as it depends on timing.
+		wantAttempts int
+	}{
+		"valid right away": {
+			validUntil:   1,
+			timeout:      time.Second,
+			wantAttempts: 1,
+		},
+		"valid after retrying": {
+			validUntil:   3,
+			timeout:      time.Second,
+			wantAttempts: 3,
+		},
+		"times out": {
+			timeout:      25 * time.Millisecond,
+			wantFailures: failing,
+		},
+		"api error": {
+			err:          errValidate,
+			timeout:      time.Second,
+			wantErr:      errValidate,
+			wantAttempts: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attempts := 0
+			failures, err := waitForValidation(t.Context(), 10*time.Millisecond, test.timeout, func(ctx context.Context) (bool, []validationFailure, error) {
+				attempts++
+				if test.err != nil {
+					return false, nil, test.err
+				}
+				if test.validUntil > 0 && attempts >= test.validUntil {
+					return true, nil, nil
+				}
+				return false, failing, nil
+			})
+
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("got error %v, want %v", err, test.wantErr)
+			}
+			if len(failures) != len(test.wantFailures) {
+				t.Errorf("got failures %v, want %v", failures, test.wantFailures)
+			}
+			if test.wantAttempts > 0 && attempts != test.wantAttempts {
+				t.Errorf("got %d attempts, want %d", attempts, test.wantAttempts)
+			}
+		})
+	}
+}
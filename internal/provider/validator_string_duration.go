@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringDuration requires a non-negative Go duration string such as "500ms" or "2m".
+func stringDuration() validatorStringDuration {
+	return validatorStringDuration{}
+}
+
+type validatorStringDuration struct{}
+
+func (v validatorStringDuration) Description(ctx context.Context) string {
+	return "Value must be a non-negative duration such as 500ms, 30s or 2m"
+}
+
+func (v validatorStringDuration) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a non-negative duration such as `500ms`, `30s` or `2m`"
+}
+
+func (v validatorStringDuration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("%s, got: %s.", v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
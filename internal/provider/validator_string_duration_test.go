@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidatorStringDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value       types.String
+		wantError   bool
+		wantInError string
+	}{
+		"duration passes": {
+			value:     types.StringValue("500ms"),
+			wantError: false,
+		},
+		"compound duration passes": {
+			value:     types.StringValue("1m30s"),
+			wantError: false,
+		},
+		"zero passes": {
+			value:     types.StringValue("0s"),
+			wantError: false,
+		},
+		"missing unit is rejected": {
+			value:       types.StringValue("30"),
+			wantError:   true,
+			wantInError: "got: 30.",
+		},
+		"negative duration is rejected": {
+			value:       types.StringValue("-1s"),
+			wantError:   true,
+			wantInError: "non-negative duration",
+		},
+		"empty string is rejected": {
+			value:     types.StringValue(""),
+			wantError: true,
+		},
+		"null is left to the schema": {
+			value:     types.StringNull(),
+			wantError: false,
+		},
+		"unknown is deferred to apply": {
+			value:     types.StringUnknown(),
+			wantError: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("validation_timeout"),
+				ConfigValue: test.value,
+			}
+			resp := &validator.StringResponse{}
+
+			stringDuration().ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != test.wantError {
+				t.Fatalf("got error = %v, want %v (%v)", got, test.wantError, resp.Diagnostics)
+			}
+			if !test.wantError {
+				return
+			}
+			if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, test.wantInError) {
+				t.Errorf("error detail %q does not contain %q", detail, test.wantInError)
+			}
+		})
+	}
+}
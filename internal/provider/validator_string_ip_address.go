@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringIPAddress requires an IPv4 or IPv6 address such as "192.0.2.1".
+func stringIPAddress() validatorStringIPAddress {
+	return validatorStringIPAddress{}
+}
+
+// stringIPAddressOrCIDR requires an IP address or a CIDR block such as
+// "192.0.2.0/24".
+func stringIPAddressOrCIDR() validatorStringIPAddress {
+	return validatorStringIPAddress{AllowCIDR: true}
+}
+
+type validatorStringIPAddress struct {
+	AllowCIDR bool
+}
+
+func (v validatorStringIPAddress) Description(ctx context.Context) string {
+	if v.AllowCIDR {
+		return "Value must be an IP address such as 192.0.2.1 or a CIDR block such as 192.0.2.0/24"
+	}
+	return "Value must be an IP address such as 192.0.2.1"
+}
+
+func (v validatorStringIPAddress) MarkdownDescription(ctx context.Context) string {
+	if v.AllowCIDR {
+		return "Value must be an IP address such as `192.0.2.1` or a CIDR block such as `192.0.2.0/24`"
+	}
+	return "Value must be an IP address such as `192.0.2.1`"
+}
+
+func (v validatorStringIPAddress) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := netip.ParseAddr(value); err == nil {
+		return
+	}
+	if v.AllowCIDR {
+		if _, err := netip.ParsePrefix(value); err == nil {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid IP address",
+		fmt.Sprintf("%s, got: %s.", v.Description(ctx), value),
+	)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidatorStringIPAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		validator   validatorStringIPAddress
+		value       types.String
+		wantError   bool
+		wantInError string
+	}{
+		"IPv4 address passes": {
+			validator: stringIPAddress(),
+			value:     types.StringValue("192.0.2.1"),
+			wantError: false,
+		},
+		"IPv6 address passes": {
+			validator: stringIPAddress(),
+			value:     types.StringValue("2001:db8::1"),
+			wantError: false,
+		},
+		"CIDR block is rejected without AllowCIDR": {
+			validator:   stringIPAddress(),
+			value:       types.StringValue("192.0.2.0/24"),
+			wantError:   true,
+			wantInError: "got: 192.0.2.0/24.",
+		},
+		"hostname is rejected": {
+			validator:   stringIPAddress(),
+			value:       types.StringValue("example.com"),
+			wantError:   true,
+			wantInError: "must be an IP address",
+		},
+		"IP address passes with AllowCIDR": {
+			validator: stringIPAddressOrCIDR(),
+			value:     types.StringValue("192.0.2.1"),
+			wantError: false,
+		},
+		"IPv4 CIDR block passes with AllowCIDR": {
+			validator: stringIPAddressOrCIDR(),
+			value:     types.StringValue("192.0.2.0/24"),
+			wantError: false,
+		},
+		"IPv6 CIDR block passes with AllowCIDR": {
+			validator: stringIPAddressOrCIDR(),
+			value:     types.StringValue("2001:db8::/32"),
+			wantError: false,
+		},
+		"out of range prefix is rejected with AllowCIDR": {
+			validator:   stringIPAddressOrCIDR(),
+			value:       types.StringValue("192.0.2.0/33"),
+			wantError:   true,
+			wantInError: "or a CIDR block",
+		},
+		"null is left to the schema": {
+			validator: stringIPAddressOrCIDR(),
+			value:     types.StringNull(),
+			wantError: false,
+		},
+		"unknown is deferred to apply": {
+			validator: stringIPAddressOrCIDR(),
+			value:     types.StringUnknown(),
+			wantError: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("ips"),
+				ConfigValue: test.value,
+			}
+			resp := &validator.StringResponse{}
+
+			test.validator.ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != test.wantError {
+				t.Fatalf("got error = %v, want %v (%v)", got, test.wantError, resp.Diagnostics)
+			}
+			if !test.wantError {
+				return
+			}
+			if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, test.wantInError) {
+				t.Errorf("error detail %q does not contain %q", detail, test.wantInError)
+			}
+		})
+	}
+}
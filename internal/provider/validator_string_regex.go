@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringRegex requires a valid RE2 regular expression, as accepted by Go's regexp package.
+func stringRegex() validatorStringRegex {
+	return validatorStringRegex{}
+}
+
+type validatorStringRegex struct{}
+
+func (v validatorStringRegex) Description(ctx context.Context) string {
+	return "Value must be a valid RE2 regular expression"
+}
+
+func (v validatorStringRegex) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a valid [RE2](https://github.com/google/re2/wiki/Syntax) regular expression"
+}
+
+func (v validatorStringRegex) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regular expression",
+			fmt.Sprintf("%s, got: %s (%s).", v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
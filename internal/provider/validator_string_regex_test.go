@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidatorStringRegex(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value       types.String
+		wantError   bool
+		wantInError string
+	}{
+		"regular expression passes": {
+			value:     types.StringValue("^test-acc-[a-z0-9]+$"),
+			wantError: false,
+		},
+		"empty string matches everything": {
+			value:     types.StringValue(""),
+			wantError: false,
+		},
+		"unbalanced parenthesis is rejected": {
+			value:       types.StringValue("(abc"),
+			wantError:   true,
+			wantInError: "missing closing )",
+		},
+		"lookahead outside RE2 is rejected": {
+			value:       types.StringValue("^(?!test)"),
+			wantError:   true,
+			wantInError: "got: ^(?!test)",
+		},
+		"null is left to the schema": {
+			value:     types.StringNull(),
+			wantError: false,
+		},
+		"unknown is deferred to apply": {
+			value:     types.StringUnknown(),
+			wantError: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("name_regex"),
+				ConfigValue: test.value,
+			}
+			resp := &validator.StringResponse{}
+
+			stringRegex().ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != test.wantError {
+				t.Fatalf("got error = %v, want %v (%v)", got, test.wantError, resp.Diagnostics)
+			}
+			if !test.wantError {
+				return
+			}
+			if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, test.wantInError) {
+				t.Errorf("error detail %q does not contain %q", detail, test.wantInError)
+			}
+		})
+	}
+}
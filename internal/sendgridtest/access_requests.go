@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+type accessRequest struct {
+	ID             int64  `json:"id"`
+	ScopeGroupName string `json:"scope_group_name"`
+	Username       string `json:"username"`
+	Email          string `json:"email"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+}
+
+// ScopeGroups are the scope groups teammates can request access to, with the
+// scopes approving a request grants.
+var ScopeGroups = map[string][]string{
+	"Billing":   {"billing.read", "billing.update"},
+	"Mail Send": {"mail.send"},
+	"Stats":     {"stats.global.read", "stats.read"},
+	"Templates": {"templates.create", "templates.delete", "templates.read", "templates.update"},
+}
+
+// RequestAccess makes the teammate named username request access to the
+// scope group named scopeGroupName, as if they had asked for it in the
+// SendGrid UI, and returns the ID of the request.
+func (s *Server) RequestAccess(username, scopeGroupName string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.teammates[username]
+	if !ok {
+		return 0, fmt.Errorf("no teammate named %q", username)
+	}
+	if t.IsAdmin {
+		return 0, fmt.Errorf("teammate %q is an admin and cannot request access", username)
+	}
+	if _, ok := ScopeGroups[scopeGroupName]; !ok {
+		return 0, fmt.Errorf("no scope group named %q", scopeGroupName)
+	}
+
+	id := s.nextID()
+	s.accessRequests[id] = &accessRequest{
+		ID:             id,
+		ScopeGroupName: scopeGroupName,
+		Username:       t.Username,
+		Email:          t.Email,
+		FirstName:      t.FirstName,
+		LastName:       t.LastName,
+	}
+	return id, nil
+}
+
+func (s *Server) routeAccessRequests() {
+	s.handle("GET /v3/scopes/requests", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, paginate(r, sorted(s.accessRequests)))
+	})
+
+	s.handle("PATCH /v3/scopes/requests/{id}/approve", func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathInt64(w, r, "id")
+		if !ok {
+			return
+		}
+		a, ok := s.accessRequests[id]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.accessRequests, id)
+
+		// Approving grants the scopes of the group to the teammate.
+		if t, ok := s.teammates[a.Username]; ok {
+			for _, scope := range ScopeGroups[a.ScopeGroupName] {
+				if !slices.Contains(t.Scopes, scope) {
+					t.Scopes = append(t.Scopes, scope)
+				}
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"scope_group_name": a.ScopeGroupName})
+	})
+
+	s.handle("DELETE /v3/scopes/requests/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathInt64(w, r, "id")
+		if !ok {
+			return
+		}
+		if _, ok := s.accessRequests[id]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.accessRequests, id)
+		writeNoContent(w)
+	})
+}
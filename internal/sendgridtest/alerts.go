@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"net/http"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type alert struct {
+	sendgrid.Alert
+}
+
+func (s *Server) routeAlerts() {
+	s.handle("GET /v3/alerts", func(w http.ResponseWriter, r *http.Request) {
+		out := []*sendgrid.Alert{}
+		for _, a := range sorted(s.alerts) {
+			v := a.Alert
+			out = append(out, &v)
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/alerts", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateAlert
+		if !decode(w, r, &in) {
+			return
+		}
+		switch in.Type {
+		case "stats_notification":
+			if in.Frequency == "" {
+				writeError(w, http.StatusBadRequest, "frequency is required for stats_notification alerts")
+				return
+			}
+		case "usage_limit":
+			if in.Percentage == 0 {
+				writeError(w, http.StatusBadRequest, "percentage is required for usage_limit alerts")
+				return
+			}
+		default:
+			writeError(w, http.StatusBadRequest, "type must be stats_notification or usage_limit")
+			return
+		}
+		if in.EmailTo == "" {
+			writeError(w, http.StatusBadRequest, "email_to is required")
+			return
+		}
+
+		t := now()
+		a := &alert{sendgrid.Alert{
+			ID:         s.nextID(),
+			EmailTo:    in.EmailTo,
+			Frequency:  in.Frequency,
+			Type:       in.Type,
+			Percentage: in.Percentage,
+			CreatedAt:  t,
+			UpdatedAt:  t,
+		}}
+		s.alerts[a.ID] = a
+
+		writeJSON(w, http.StatusCreated, a.Alert)
+	})
+
+	s.handle("GET /v3/alerts/{id}", func(w http.ResponseWriter, r *http.Request) {
+		a, ok := s.alertByPath(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, a.Alert)
+	})
+
+	s.handle("PATCH /v3/alerts/{id}", func(w http.ResponseWriter, r *http.Request) {
+		a, ok := s.alertByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputUpdateAlert
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.EmailTo != "" {
+			a.EmailTo = in.EmailTo
+		}
+		if in.Frequency != "" {
+			a.Frequency = in.Frequency
+		}
+		if in.Percentage != 0 {
+			a.Percentage = in.Percentage
+		}
+		a.UpdatedAt = now()
+		writeJSON(w, http.StatusOK, a.Alert)
+	})
+
+	s.handle("DELETE /v3/alerts/{id}", func(w http.ResponseWriter, r *http.Request) {
+		a, ok := s.alertByPath(w, r)
+		if !ok {
+			return
+		}
+		delete(s.alerts, a.ID)
+		writeNoContent(w)
+	})
+}
+
+func (s *Server) alertByPath(w http.ResponseWriter, r *http.Request) (*alert, bool) {
+	id, ok := pathInt64(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	a, ok := s.alerts[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return a, true
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+// apiKeyDefaultScopes are added by SendGrid to every API key.
+var apiKeyDefaultScopes = []string{
+	"sender_verification_exempt",
+	"sender_verification_eligible",
+	"2fa_required",
+}
+
+type apiKey struct {
+	sendgrid.OutputGetAPIKey
+
+	Key string
+}
+
+func withAPIKeyDefaultScopes(scopes []string) []string {
+	out := slices.Clone(scopes)
+	for _, s := range apiKeyDefaultScopes {
+		if !slices.Contains(out, s) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func (s *Server) routeAPIKeys() {
+	s.handle("GET /v3/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		out := sendgrid.OutputGetAPIKeys{APIKeys: []sendgrid.APIKey{}}
+		for _, k := range paginate(r, sorted(s.apiKeys)) {
+			out.APIKeys = append(out.APIKeys, sendgrid.APIKey{
+				ApiKeyId: k.ApiKeyId,
+				Name:     k.Name,
+			})
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateAPIKey
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name == "" {
+			writeError(w, http.StatusBadRequest, "name is required")
+			return
+		}
+
+		id := fmt.Sprintf("key%019d", s.nextID())
+		k := &apiKey{
+			OutputGetAPIKey: sendgrid.OutputGetAPIKey{
+				ApiKeyId: id,
+				Name:     in.Name,
+				Scopes:   withAPIKeyDefaultScopes(in.Scopes),
+			},
+			Key: fmt.Sprintf("SG.%s.fake", id),
+		}
+		s.apiKeys[id] = k
+
+		writeJSON(w, http.StatusCreated, sendgrid.OutputCreateAPIKey{
+			ApiKey:   k.Key,
+			ApiKeyId: k.ApiKeyId,
+			Name:     k.Name,
+			Scopes:   k.Scopes,
+		})
+	})
+
+	s.handle("GET /v3/api_keys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		k, ok := s.apiKeys[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, k.OutputGetAPIKey)
+	})
+
+	s.handle("PATCH /v3/api_keys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		k, ok := s.apiKeys[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateAPIKeyName
+		if !decode(w, r, &in) {
+			return
+		}
+		k.Name = in.Name
+		writeJSON(w, http.StatusOK, sendgrid.OutputUpdateAPIKeyName{
+			ApiKeyId: k.ApiKeyId,
+			Name:     k.Name,
+		})
+	})
+
+	s.handle("PUT /v3/api_keys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		k, ok := s.apiKeys[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateAPIKeyNameAndScopes
+		if !decode(w, r, &in) {
+			return
+		}
+		k.Name = in.Name
+		k.Scopes = withAPIKeyDefaultScopes(in.Scopes)
+		writeJSON(w, http.StatusOK, sendgrid.OutputUpdateAPIKeyNameAndScopes{
+			ApiKeyId: k.ApiKeyId,
+			Name:     k.Name,
+			Scopes:   k.Scopes,
+		})
+	})
+
+	s.handle("DELETE /v3/api_keys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.apiKeys[id]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.apiKeys, id)
+		writeNoContent(w)
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"net/http"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type asmGroup struct {
+	sendgrid.SuppressionGroup
+
+	// suppressions maps the suppressed addresses to the time they were
+	// added.
+	suppressions map[string]int64
+}
+
+func (s *Server) clearDefaultASMGroup() {
+	for _, g := range s.asmGroups {
+		g.IsDefault = false
+	}
+}
+
+func (s *Server) routeASMGroups() {
+	s.handle("GET /v3/asm/groups", func(w http.ResponseWriter, r *http.Request) {
+		out := []*sendgrid.SuppressionGroup{}
+		for _, g := range sorted(s.asmGroups) {
+			v := g.SuppressionGroup
+			out = append(out, &v)
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/asm/groups", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateSuppressionGroup
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name == "" {
+			writeError(w, http.StatusBadRequest, "name is required")
+			return
+		}
+		for _, g := range s.asmGroups {
+			if g.Name == in.Name {
+				writeError(w, http.StatusBadRequest, "Another group with this name already exists.")
+				return
+			}
+		}
+		if in.IsDefault {
+			s.clearDefaultASMGroup()
+		}
+
+		g := &asmGroup{sendgrid.SuppressionGroup{
+			ID:          s.nextID(),
+			Name:        in.Name,
+			Description: in.Description,
+			IsDefault:   in.IsDefault,
+		}, map[string]int64{}}
+		s.asmGroups[g.ID] = g
+
+		writeJSON(w, http.StatusCreated, sendgrid.OutputCreateSuppressionGroup{
+			ID:          g.ID,
+			Name:        g.Name,
+			Description: g.Description,
+			IsDefault:   g.IsDefault,
+		})
+	})
+
+	s.handle("GET /v3/asm/groups/{id}", func(w http.ResponseWriter, r *http.Request) {
+		g, ok := s.asmGroupByPath(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, g.SuppressionGroup)
+	})
+
+	s.handle("PATCH /v3/asm/groups/{id}", func(w http.ResponseWriter, r *http.Request) {
+		g, ok := s.asmGroupByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputUpdateSuppressionGroup
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name != "" {
+			g.Name = in.Name
+		}
+		if in.Description != "" {
+			g.Description = in.Description
+		}
+		if in.IsDefault {
+			s.clearDefaultASMGroup()
+		}
+		g.IsDefault = in.IsDefault
+		writeJSON(w, http.StatusCreated, g.SuppressionGroup)
+	})
+
+	s.handle("DELETE /v3/asm/groups/{id}", func(w http.ResponseWriter, r *http.Request) {
+		g, ok := s.asmGroupByPath(w, r)
+		if !ok {
+			return
+		}
+		delete(s.asmGroups, g.ID)
+		writeNoContent(w)
+	})
+}
+
+func (s *Server) asmGroupByPath(w http.ResponseWriter, r *http.Request) (*asmGroup, bool) {
+	id, ok := pathInt64(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	g, ok := s.asmGroups[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return g, true
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type design struct {
+	sendgrid.OutputGetDesign
+}
+
+func (d *design) summary() *sendgrid.Design {
+	return &sendgrid.Design{
+		ID:                   d.ID,
+		UpdatedAt:            d.UpdatedAt,
+		CreatedAt:            d.CreatedAt,
+		ThumbnailURL:         d.ThumbnailURL,
+		Name:                 d.Name,
+		Editor:               d.Editor,
+		Subject:              d.Subject,
+		Categories:           d.Categories,
+		GeneratePlainContent: d.GeneratePlainContent,
+	}
+}
+
+func (s *Server) routeDesigns() {
+	s.handle("GET /v3/designs", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		pageSize := queryInt(r, "page_size", 100)
+		offset, _ := strconv.Atoi(q.Get("page_token"))
+
+		all := sorted(s.designs)
+		out := sendgrid.OutputGetDesigns{Result: []*sendgrid.Design{}}
+		out.Metadata.Count = int64(len(all))
+		if offset < len(all) {
+			end := min(offset+pageSize, len(all))
+			for _, d := range all[offset:end] {
+				out.Result = append(out.Result, d.summary())
+			}
+			if end < len(all) {
+				out.Metadata.Next = fmt.Sprintf("%s/designs?page_size=%d&page_token=%d", s.BaseURL(), pageSize, end)
+			}
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/designs", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateDesign
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.HTMLContent == "" {
+			writeError(w, http.StatusBadRequest, "html_content is required")
+			return
+		}
+		name := in.Name
+		if name == "" {
+			name = "My Design"
+		}
+		editor := in.Editor
+		if editor == "" {
+			editor = "code"
+		}
+
+		id := s.nextUUID()
+		t := timestamp()
+		d := &design{sendgrid.OutputGetDesign{
+			ID:                   id,
+			UpdatedAt:            t,
+			CreatedAt:            t,
+			ThumbnailURL:         fmt.Sprintf("https://fake.sendgrid.test/thumbnails/%s.png", id),
+			Name:                 name,
+			Editor:               editor,
+			HTMLContent:          in.HTMLContent,
+			PlainContent:         in.PlainContent,
+			GeneratePlainContent: in.GeneratePlainContent,
+			Subject:              in.Subject,
+			Categories:           in.Categories,
+		}}
+		if d.GeneratePlainContent {
+			d.PlainContent = plainContent(d.HTMLContent)
+		}
+		s.designs[id] = d
+
+		writeJSON(w, http.StatusCreated, d.OutputGetDesign)
+	})
+
+	s.handle("GET /v3/designs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := s.designs[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, d.OutputGetDesign)
+	})
+
+	s.handle("PATCH /v3/designs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := s.designs[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateDesign
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name != "" {
+			d.Name = in.Name
+		}
+		if in.HTMLContent != "" {
+			d.HTMLContent = in.HTMLContent
+		}
+		if in.PlainContent != "" {
+			d.PlainContent = in.PlainContent
+		}
+		if in.Subject != "" {
+			d.Subject = in.Subject
+		}
+		if in.Categories != nil {
+			d.Categories = in.Categories
+		}
+		d.GeneratePlainContent = in.GeneratePlainContent
+		if d.GeneratePlainContent {
+			d.PlainContent = plainContent(d.HTMLContent)
+		}
+		d.UpdatedAt = timestamp()
+		writeJSON(w, http.StatusOK, d.OutputGetDesign)
+	})
+
+	s.handle("DELETE /v3/designs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.designs[id]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.designs, id)
+		writeNoContent(w)
+	})
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PublishDNSRecord marks the DNS record for host as published, so that
+// validating a domain, branded link or reverse DNS record that requires it
+// succeeds. Hosts are compared case-insensitively.
+func (s *Server) PublishDNSRecord(host string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.publishedDNS[strings.ToLower(host)] = true
+}
+
+// UnpublishDNSRecord reverses PublishDNSRecord.
+func (s *Server) UnpublishDNSRecord(host string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.publishedDNS, strings.ToLower(host))
+}
+
+func (s *Server) dnsPublished(host string) bool {
+	return s.publishedDNS[strings.ToLower(host)]
+}
+
+// dnsReason returns the failure reason SendGrid reports when a record of
+// the given type cannot be found.
+func dnsReason(recordType, host, data string) string {
+	return fmt.Sprintf("Expected %s for %q to match %q.", strings.ToUpper(recordType), host, data)
+}
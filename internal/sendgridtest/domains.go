@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type domain struct {
+	sendgrid.DomainAuthentication
+}
+
+// newDomainDNS returns the records SendGrid asks the user to publish for a
+// domain.
+func newDomainDNS(id int64, fqdn, domainName string, automaticSecurity bool, dkimSelector string) sendgrid.DNS {
+	if dkimSelector == "" {
+		dkimSelector = "s"
+	}
+	if automaticSecurity {
+		return sendgrid.DNS{
+			MailCname: sendgrid.Record{
+				Type: "cname",
+				Host: fqdn,
+				Data: fmt.Sprintf("u%d.wl.sendgrid.net", id),
+			},
+			Dkim1: sendgrid.Record{
+				Type: "cname",
+				Host: fmt.Sprintf("%s1._domainkey.%s", dkimSelector, domainName),
+				Data: fmt.Sprintf("%s1.domainkey.u%d.wl.sendgrid.net", dkimSelector, id),
+			},
+			Dkim2: sendgrid.Record{
+				Type: "cname",
+				Host: fmt.Sprintf("%s2._domainkey.%s", dkimSelector, domainName),
+				Data: fmt.Sprintf("%s2.domainkey.u%d.wl.sendgrid.net", dkimSelector, id),
+			},
+		}
+	}
+	return sendgrid.DNS{
+		MailServer: sendgrid.Record{
+			Type: "mx",
+			Host: fqdn,
+			Data: "mx.sendgrid.net",
+		},
+		SubdomainSpf: sendgrid.Record{
+			Type: "txt",
+			Host: fqdn,
+			Data: "v=spf1 include:sendgrid.net ~all",
+		},
+		Dkim: sendgrid.Record{
+			Type: "txt",
+			Host: fmt.Sprintf("%s._domainkey.%s", dkimSelector, domainName),
+			Data: "k=rsa; t=s; p=FAKE",
+		},
+	}
+}
+
+// userID returns the user ID of the account owner or of the named subuser.
+func (s *Server) userID(username string) int64 {
+	if su, ok := s.subusers[username]; ok {
+		return su.ID
+	}
+	return s.ownerID
+}
+
+func (s *Server) validateRecord(rec *sendgrid.Record) sendgrid.ValidationResult {
+	if rec.Type == "" {
+		return sendgrid.ValidationResult{Valid: true}
+	}
+	rec.Valid = s.dnsPublished(rec.Host)
+	if rec.Valid {
+		return sendgrid.ValidationResult{Valid: true}
+	}
+	return sendgrid.ValidationResult{Reason: dnsReason(rec.Type, rec.Host, rec.Data)}
+}
+
+func (s *Server) routeDomains() {
+	s.handle("GET /v3/whitelabel/domains", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		out := []*sendgrid.DomainAuthentication{}
+		for _, d := range sorted(s.domains) {
+			if v := q.Get("domain"); v != "" && d.Domain != v {
+				continue
+			}
+			if v := q.Get("username"); v != "" && d.Username != v {
+				continue
+			}
+			if q.Get("exclude_subusers") == "true" && d.Username != OwnerUsername {
+				continue
+			}
+			v := d.DomainAuthentication
+			out = append(out, &v)
+		}
+		writeJSON(w, http.StatusOK, paginate(r, out))
+	})
+
+	s.handle("GET /v3/whitelabel/domains/default", func(w http.ResponseWriter, r *http.Request) {
+		for _, d := range sorted(s.domains) {
+			if !d.Default {
+				continue
+			}
+			if v := r.URL.Query().Get("domain"); v != "" && d.Domain != v {
+				continue
+			}
+			writeJSON(w, http.StatusOK, d.DomainAuthentication)
+			return
+		}
+		writeJSON(w, http.StatusOK, sendgrid.OutputGetDefaultAuthentication{})
+	})
+
+	s.handle("POST /v3/whitelabel/domains", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputAuthenticateDomain
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Domain == "" {
+			writeError(w, http.StatusBadRequest, "domain is required")
+			return
+		}
+		username := OwnerUsername
+		if in.Username != "" {
+			if _, ok := s.subusers[in.Username]; !ok {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("username %s does not exist", in.Username))
+				return
+			}
+			username = in.Username
+		}
+		if ip, ok := s.invalidIP(in.IPs); ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unable to assign ip %s", ip))
+			return
+		}
+
+		id := s.nextID()
+		subdomain := in.Subdomain
+		if subdomain == "" {
+			subdomain = fmt.Sprintf("em%d", id)
+		}
+		fqdn := subdomain + "." + in.Domain
+		for _, d := range s.domains {
+			if d.Subdomain+"."+d.Domain == fqdn && d.Username == username {
+				writeError(w, http.StatusBadRequest, "A domain with this subdomain already exists.")
+				return
+			}
+		}
+		if in.Default {
+			s.clearDefaultDomain()
+		}
+
+		d := &domain{DomainAuthentication: sendgrid.DomainAuthentication{
+			ID:                id,
+			UserID:            s.userID(username),
+			Subdomain:         subdomain,
+			Domain:            in.Domain,
+			Username:          username,
+			IPs:               in.IPs,
+			CustomSpf:         in.CustomSpf,
+			Default:           in.Default,
+			AutomaticSecurity: in.AutomaticSecurity,
+			DNS:               newDomainDNS(id, fqdn, in.Domain, in.AutomaticSecurity, in.CustomDkimSelector),
+			Subusers:          []sendgrid.SubuserSenderAuthentication{},
+		}}
+		s.domains[id] = d
+
+		writeJSON(w, http.StatusCreated, d.DomainAuthentication)
+	})
+
+	s.handle("GET /v3/whitelabel/domains/subuser", func(w http.ResponseWriter, r *http.Request) {
+		username := r.URL.Query().Get("username")
+		for _, d := range sorted(s.domains) {
+			for _, su := range d.Subusers {
+				if su.Username == username {
+					writeJSON(w, http.StatusOK, d.DomainAuthentication)
+					return
+				}
+			}
+		}
+		writeNotFound(w)
+	})
+
+	s.handle("DELETE /v3/whitelabel/domains/subuser", func(w http.ResponseWriter, r *http.Request) {
+		username := r.URL.Query().Get("username")
+		for _, d := range s.domains {
+			for i, su := range d.Subusers {
+				if su.Username == username {
+					d.Subusers = append(d.Subusers[:i], d.Subusers[i+1:]...)
+					writeNoContent(w)
+					return
+				}
+			}
+		}
+		writeNotFound(w)
+	})
+
+	s.handle("GET /v3/whitelabel/domains/{id}", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := s.domainByPath(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, d.DomainAuthentication)
+	})
+
+	s.handle("PATCH /v3/whitelabel/domains/{id}", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := s.domainByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputUpdateDomainAuthentication
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Default {
+			s.clearDefaultDomain()
+		}
+		d.Default = in.Default
+		d.CustomSpf = in.CustomSpf
+		writeJSON(w, http.StatusOK, d.DomainAuthentication)
+	})
+
+	s.handle("DELETE /v3/whitelabel/domains/{id}", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := s.domainByPath(w, r)
+		if !ok {
+			return
+		}
+		delete(s.domains, d.ID)
+		writeNoContent(w)
+	})
+
+	s.handle("POST /v3/whitelabel/domains/{id}/ips", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := s.domainByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputAddIPToAuthenticatedDomain
+		if !decode(w, r, &in) {
+			return
+		}
+		if !s.hasIP(in.IP) {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unable to assign ip %s", in.IP))
+			return
+		}
+		d.IPs = append(d.IPs, in.IP)
+		writeJSON(w, http.StatusOK, d.DomainAuthentication)
+	})
+
+	s.handle("DELETE /v3/whitelabel/domains/{id}/ips/{ip}", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := s.domainByPath(w, r)
+		if !ok {
+			return
+		}
+		ip := r.PathValue("ip")
+		for i, v := range d.IPs {
+			if v == ip {
+				d.IPs = append(d.IPs[:i], d.IPs[i+1:]...)
+				writeJSON(w, http.StatusOK, d.DomainAuthentication)
+				return
+			}
+		}
+		writeNotFound(w)
+	})
+
+	s.handle("POST /v3/whitelabel/domains/{id}/validate", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := s.domainByPath(w, r)
+		if !ok {
+			return
+		}
+		results := sendgrid.ValidationResults{
+			MailCname: s.validateRecord(&d.DNS.MailCname),
+			Dkim1:     s.validateRecord(&d.DNS.Dkim1),
+			Dkim2:     s.validateRecord(&d.DNS.Dkim2),
+			SPF:       sendgrid.ValidationResult{Valid: true},
+		}
+		// Domains without automatic security publish their own records.
+		for _, rec := range []*sendgrid.Record{&d.DNS.MailServer, &d.DNS.SubdomainSpf, &d.DNS.Dkim} {
+			s.validateRecord(rec)
+		}
+		d.Valid = allValid(d.DNS.MailCname, d.DNS.Dkim1, d.DNS.Dkim2, d.DNS.MailServer, d.DNS.SubdomainSpf, d.DNS.Dkim)
+		d.LastValidationAttemptAt = now()
+
+		writeJSON(w, http.StatusOK, sendgrid.OutputValidateDomainAuthentication{
+			ID:                d.ID,
+			Valid:             d.Valid,
+			ValidationResults: results,
+		})
+	})
+
+	s.handle("POST /v3/whitelabel/domains/{id}/subuser", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := s.domainByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputAssociateAuthenticatedDomainWithSubuser
+		if !decode(w, r, &in) {
+			return
+		}
+		su, ok := s.subusers[in.Username]
+		if !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("username %s does not exist", in.Username))
+			return
+		}
+		for _, other := range s.domains {
+			for _, a := range other.Subusers {
+				if a.Username == in.Username {
+					writeError(w, http.StatusBadRequest, "subuser is already associated with a domain")
+					return
+				}
+			}
+		}
+		d.Subusers = append(d.Subusers, sendgrid.SubuserSenderAuthentication{
+			UserID:   su.ID,
+			Username: su.Username,
+		})
+		writeJSON(w, http.StatusCreated, d.DomainAuthentication)
+	})
+}
+
+func (s *Server) domainByPath(w http.ResponseWriter, r *http.Request) (*domain, bool) {
+	id, ok := pathInt64(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	d, ok := s.domains[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return d, true
+}
+
+func (s *Server) clearDefaultDomain() {
+	for _, d := range s.domains {
+		d.Default = false
+	}
+}
+
+func allValid(records ...sendgrid.Record) bool {
+	for _, rec := range records {
+		if rec.Type != "" && !rec.Valid {
+			return false
+		}
+	}
+	return true
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
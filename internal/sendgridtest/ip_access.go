@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"cmp"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type (
+	allowedIP      = sendgrid.AllowedIP
+	accessActivity = sendgrid.AccessActivity
+)
+
+// remoteIP returns the address a request was made from.
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// allowedAccess reports whether the IP access allow list lets ip in. Like
+// SendGrid, an empty allow list lets every IP in.
+func (s *Server) allowedAccess(ip string) bool {
+	if len(s.allowedIPs) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	for _, a := range s.allowedIPs {
+		if strings.Contains(a.IP, "/") {
+			if p, err := netip.ParsePrefix(a.IP); err == nil && p.Contains(addr) {
+				return true
+			}
+			continue
+		}
+		if a.IP == ip {
+			return true
+		}
+	}
+	return false
+}
+
+// recordAccess adds a request to the access activity of the account and
+// reports whether it is allowed through.
+func (s *Server) recordAccess(r *http.Request) bool {
+	ip := remoteIP(r)
+	allowed := s.allowedAccess(ip)
+
+	t := now()
+	a, ok := s.accessActivity[ip]
+	if !ok {
+		a = &accessActivity{IP: ip, AuthMethod: "api", FirstAt: t}
+		s.accessActivity[ip] = a
+	}
+	a.Allowed = allowed
+	a.LastAt = t
+	return allowed
+}
+
+func (s *Server) routeIPAccess() {
+	s.handle("GET /v3/access_settings/whitelist", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{"result": sorted(s.allowedIPs)})
+	})
+
+	s.handle("POST /v3/access_settings/whitelist", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputAddIPsToAllowList
+		if !decode(w, r, &in) {
+			return
+		}
+		if len(in.IPs) == 0 {
+			writeError(w, http.StatusBadRequest, "ips are required")
+			return
+		}
+		for _, ip := range in.IPs {
+			if _, err := netip.ParsePrefix(ip.IP); err == nil {
+				continue
+			}
+			if _, err := netip.ParseAddr(ip.IP); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid ip address: "+ip.IP)
+				return
+			}
+		}
+
+		result := make([]*allowedIP, 0, len(in.IPs))
+		for _, ip := range in.IPs {
+			t := now()
+			a := &allowedIP{ID: s.nextID(), IP: ip.IP, CreatedAt: t, UpdatedAt: t}
+			s.allowedIPs[a.ID] = a
+			result = append(result, a)
+		}
+		writeJSON(w, http.StatusCreated, map[string]any{"result": result})
+	})
+
+	s.handle("DELETE /v3/access_settings/whitelist", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputRemoveIPsFromAllowList
+		if !decode(w, r, &in) {
+			return
+		}
+		for _, id := range in.IDs {
+			if _, ok := s.allowedIPs[id]; !ok {
+				writeNotFound(w)
+				return
+			}
+		}
+		for _, id := range in.IDs {
+			delete(s.allowedIPs, id)
+		}
+		writeNoContent(w)
+	})
+
+	s.handle("GET /v3/access_settings/whitelist/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathInt64(w, r, "id")
+		if !ok {
+			return
+		}
+		a, ok := s.allowedIPs[id]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"result": []*allowedIP{a}})
+	})
+
+	s.handle("DELETE /v3/access_settings/whitelist/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathInt64(w, r, "id")
+		if !ok {
+			return
+		}
+		if _, ok := s.allowedIPs[id]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.allowedIPs, id)
+		writeNoContent(w)
+	})
+
+	// Activity is listed most recent first, 20 entries at most.
+	s.handle("GET /v3/access_settings/activity", func(w http.ResponseWriter, r *http.Request) {
+		activity := sorted(s.accessActivity)
+		slices.SortStableFunc(activity, func(a, b *accessActivity) int {
+			return cmp.Compare(b.LastAt, a.LastAt)
+		})
+
+		limit := min(queryInt(r, "limit", 20), 20)
+		if limit > 0 && limit < len(activity) {
+			activity = activity[:limit]
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"result": activity})
+	})
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type ipPool struct {
+	Name string
+	IPs  []string
+}
+
+func (s *Server) poolsOf(ip string) []string {
+	pools := []string{}
+	for _, p := range sorted(s.ipPools) {
+		if slices.Contains(p.IPs, ip) {
+			pools = append(pools, p.Name)
+		}
+	}
+	return pools
+}
+
+func (s *Server) routeIPPools() {
+	s.handle("GET /v3/ips/pools", func(w http.ResponseWriter, r *http.Request) {
+		out := []*sendgrid.IPPool{}
+		for _, p := range sorted(s.ipPools) {
+			out = append(out, &sendgrid.IPPool{Name: p.Name})
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/ips/pools", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateIPPool
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name == "" {
+			writeError(w, http.StatusBadRequest, "name is required")
+			return
+		}
+		if _, ok := s.ipPools[in.Name]; ok {
+			writeError(w, http.StatusBadRequest, "pool name already exists")
+			return
+		}
+		s.ipPools[in.Name] = &ipPool{Name: in.Name, IPs: []string{}}
+		writeJSON(w, http.StatusOK, sendgrid.OutputCreateIPPool{Name: in.Name})
+	})
+
+	s.handle("GET /v3/ips/pools/{name}", func(w http.ResponseWriter, r *http.Request) {
+		p, ok := s.ipPools[r.PathValue("name")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		out := sendgrid.OutputGetIPPool{PoolName: p.Name, IPs: []*sendgrid.IP{}}
+		for _, ip := range p.IPs {
+			out.IPs = append(out.IPs, &sendgrid.IP{IP: ip})
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("PUT /v3/ips/pools/{name}", func(w http.ResponseWriter, r *http.Request) {
+		p, ok := s.ipPools[r.PathValue("name")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateIPPool
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name == "" {
+			writeError(w, http.StatusBadRequest, "name is required")
+			return
+		}
+		if _, ok := s.ipPools[in.Name]; ok && in.Name != p.Name {
+			writeError(w, http.StatusBadRequest, "pool name already exists")
+			return
+		}
+		delete(s.ipPools, p.Name)
+		p.Name = in.Name
+		s.ipPools[p.Name] = p
+		writeJSON(w, http.StatusOK, sendgrid.OutputUpdateIPPool{Name: p.Name})
+	})
+
+	s.handle("DELETE /v3/ips/pools/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+		if _, ok := s.ipPools[name]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.ipPools, name)
+		writeNoContent(w)
+	})
+
+	s.handle("POST /v3/ips/pools/{name}/ips", func(w http.ResponseWriter, r *http.Request) {
+		p, ok := s.ipPools[r.PathValue("name")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputAddIPToPool
+		if !decode(w, r, &in) {
+			return
+		}
+		if !s.hasIP(in.IP) {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("ip %s does not belong to this account", in.IP))
+			return
+		}
+		if slices.Contains(p.IPs, in.IP) {
+			writeError(w, http.StatusBadRequest, "ip is already in the pool")
+			return
+		}
+		p.IPs = append(p.IPs, in.IP)
+		writeJSON(w, http.StatusCreated, sendgrid.OutputAddIPToPool{
+			IP:    in.IP,
+			Pools: s.poolsOf(in.IP),
+		})
+	})
+
+	s.handle("DELETE /v3/ips/pools/{name}/ips/{ip}", func(w http.ResponseWriter, r *http.Request) {
+		p, ok := s.ipPools[r.PathValue("name")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		i := slices.Index(p.IPs, r.PathValue("ip"))
+		if i < 0 {
+			writeNotFound(w)
+			return
+		}
+		p.IPs = slices.Delete(p.IPs, i, i+1)
+		writeNoContent(w)
+	})
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type link struct {
+	sendgrid.BrandedLink
+
+	Subusers []string
+}
+
+func (s *Server) routeLinks() {
+	s.handle("GET /v3/whitelabel/links", func(w http.ResponseWriter, r *http.Request) {
+		out := []*sendgrid.BrandedLink{}
+		for _, l := range sorted(s.links) {
+			v := l.BrandedLink
+			out = append(out, &v)
+		}
+		writeJSON(w, http.StatusOK, paginate(r, out))
+	})
+
+	s.handle("GET /v3/whitelabel/links/default", func(w http.ResponseWriter, r *http.Request) {
+		for _, l := range sorted(s.links) {
+			if l.Default {
+				writeJSON(w, http.StatusOK, l.BrandedLink)
+				return
+			}
+		}
+		writeJSON(w, http.StatusOK, sendgrid.OutputGetDefaultBrandedLink{})
+	})
+
+	s.handle("POST /v3/whitelabel/links", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateBrandedLink
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Domain == "" {
+			writeError(w, http.StatusBadRequest, "domain is required")
+			return
+		}
+
+		id := s.nextID()
+		subdomain := in.Subdomain
+		if subdomain == "" {
+			subdomain = "url" + formatID(id)
+		}
+		fqdn := subdomain + "." + in.Domain
+		for _, l := range s.links {
+			if l.Subdomain+"."+l.Domain == fqdn {
+				writeError(w, http.StatusBadRequest, "A link branding with this subdomain already exists.")
+				return
+			}
+		}
+		if in.Default {
+			s.clearDefaultLink()
+		}
+
+		l := &link{BrandedLink: sendgrid.BrandedLink{
+			ID:        id,
+			Domain:    in.Domain,
+			Subdomain: subdomain,
+			Username:  OwnerUsername,
+			UserID:    s.ownerID,
+			Default:   in.Default,
+			DNS: sendgrid.DNSBrandedLink{
+				DomainCname: sendgrid.Record{
+					Type: "cname",
+					Host: fqdn,
+					Data: "sendgrid.net",
+				},
+				OwnerCname: sendgrid.Record{
+					Type: "cname",
+					Host: fmt.Sprintf("%d.%s", s.ownerID, in.Domain),
+					Data: "sendgrid.net",
+				},
+			},
+		}}
+		s.links[id] = l
+
+		writeJSON(w, http.StatusCreated, l.BrandedLink)
+	})
+
+	s.handle("GET /v3/whitelabel/links/subuser", func(w http.ResponseWriter, r *http.Request) {
+		if l := s.linkBySubuser(r.URL.Query().Get("username")); l != nil {
+			writeJSON(w, http.StatusOK, l.BrandedLink)
+			return
+		}
+		writeNotFound(w)
+	})
+
+	s.handle("DELETE /v3/whitelabel/links/subuser", func(w http.ResponseWriter, r *http.Request) {
+		username := r.URL.Query().Get("username")
+		l := s.linkBySubuser(username)
+		if l == nil {
+			writeNotFound(w)
+			return
+		}
+		for i, v := range l.Subusers {
+			if v == username {
+				l.Subusers = append(l.Subusers[:i], l.Subusers[i+1:]...)
+				break
+			}
+		}
+		writeNoContent(w)
+	})
+
+	s.handle("GET /v3/whitelabel/links/{id}", func(w http.ResponseWriter, r *http.Request) {
+		l, ok := s.linkByPath(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, l.BrandedLink)
+	})
+
+	s.handle("PATCH /v3/whitelabel/links/{id}", func(w http.ResponseWriter, r *http.Request) {
+		l, ok := s.linkByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputUpdateBrandedLink
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Default {
+			s.clearDefaultLink()
+		}
+		l.Default = in.Default
+		writeJSON(w, http.StatusOK, l.BrandedLink)
+	})
+
+	s.handle("DELETE /v3/whitelabel/links/{id}", func(w http.ResponseWriter, r *http.Request) {
+		l, ok := s.linkByPath(w, r)
+		if !ok {
+			return
+		}
+		delete(s.links, l.ID)
+		writeNoContent(w)
+	})
+
+	s.handle("POST /v3/whitelabel/links/{id}/validate", func(w http.ResponseWriter, r *http.Request) {
+		l, ok := s.linkByPath(w, r)
+		if !ok {
+			return
+		}
+		results := sendgrid.ValidationResultsBrandedLink{
+			DomainCname: s.validateRecord(&l.DNS.DomainCname),
+			OwnerCname:  s.validateRecord(&l.DNS.OwnerCname),
+		}
+		l.Valid = allValid(l.DNS.DomainCname, l.DNS.OwnerCname)
+
+		writeJSON(w, http.StatusOK, sendgrid.OutputValidateBrandedLink{
+			ID:                l.ID,
+			Valid:             l.Valid,
+			ValidationResults: results,
+		})
+	})
+
+	s.handle("POST /v3/whitelabel/links/{id}/subuser", func(w http.ResponseWriter, r *http.Request) {
+		l, ok := s.linkByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputAssociateBrandedLinkWithSubuser
+		if !decode(w, r, &in) {
+			return
+		}
+		if _, ok := s.subusers[in.Username]; !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("username %s does not exist", in.Username))
+			return
+		}
+		if s.linkBySubuser(in.Username) != nil {
+			writeError(w, http.StatusBadRequest, "subuser is already associated with a link branding")
+			return
+		}
+		l.Subusers = append(l.Subusers, in.Username)
+		writeJSON(w, http.StatusOK, l.BrandedLink)
+	})
+}
+
+func (s *Server) linkByPath(w http.ResponseWriter, r *http.Request) (*link, bool) {
+	id, ok := pathInt64(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	l, ok := s.links[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return l, true
+}
+
+func (s *Server) linkBySubuser(username string) *link {
+	for _, l := range sorted(s.links) {
+		for _, v := range l.Subusers {
+			if v == username {
+				return l
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) clearDefaultLink() {
+	for _, l := range s.links {
+		l.Default = false
+	}
+}
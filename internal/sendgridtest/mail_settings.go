@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// newMailSettings returns the mail settings of a new account, keyed by the
+// name used in their /v3/mail_settings/{name} endpoint. The bounce purge
+// setting has its own typed handler.
+func newMailSettings() map[string]map[string]any {
+	return map[string]map[string]any{
+		"address_whitelist":             {"enabled": false, "list": []any{}},
+		"bcc":                           {"enabled": false, "email": ""},
+		"bypass_list_management":        {"enabled": false},
+		"bypass_spam_management":        {"enabled": false},
+		"bypass_bounce_management":      {"enabled": false},
+		"bypass_unsubscribe_management": {"enabled": false},
+		"footer":                        {"enabled": false, "html_content": "", "plain_content": ""},
+		"forward_bounce":                {"enabled": false, "email": ""},
+		"forward_spam":                  {"enabled": false, "email": ""},
+		"template":                      {"enabled": false, "html_content": ""},
+		"plain_content":                 {"enabled": false},
+	}
+}
+
+func (s *Server) routeMailSettings() {
+	s.handle("GET /v3/mail_settings/{setting}", func(w http.ResponseWriter, r *http.Request) {
+		setting, ok := s.mailSettings[r.PathValue("setting")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, setting)
+	})
+
+	// Like SendGrid, PATCH only changes the fields present in the body.
+	s.handle("PATCH /v3/mail_settings/{setting}", func(w http.ResponseWriter, r *http.Request) {
+		setting, ok := s.mailSettings[r.PathValue("setting")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in map[string]any
+		if !decode(w, r, &in) {
+			return
+		}
+		for k, v := range in {
+			current, ok := setting[k]
+			if !ok || reflect.TypeOf(current) != reflect.TypeOf(v) {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid field %q", k))
+				return
+			}
+		}
+		for k, v := range in {
+			setting[k] = v
+		}
+		writeJSON(w, http.StatusOK, setting)
+	})
+}
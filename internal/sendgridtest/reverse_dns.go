@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type reverseDNS struct {
+	sendgrid.OutputGetReverseDNS
+}
+
+func (s *Server) routeReverseDNS() {
+	s.handle("GET /v3/whitelabel/ips", func(w http.ResponseWriter, r *http.Request) {
+		out := []*sendgrid.OutputGetReverseDNS{}
+		for _, v := range sorted(s.reverseDNS) {
+			if ip := r.URL.Query().Get("ip"); ip != "" && !strings.HasPrefix(v.IP, ip) {
+				continue
+			}
+			o := v.OutputGetReverseDNS
+			out = append(out, &o)
+		}
+		writeJSON(w, http.StatusOK, paginate(r, out))
+	})
+
+	s.handle("POST /v3/whitelabel/ips", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateReverseDNS
+		if !decode(w, r, &in) {
+			return
+		}
+		switch {
+		case in.IP == "":
+			writeError(w, http.StatusBadRequest, "ip is required")
+			return
+		case in.Domain == "":
+			writeError(w, http.StatusBadRequest, "domain is required")
+			return
+		case !s.hasIP(in.IP):
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("ip %s does not belong to this account", in.IP))
+			return
+		}
+		for _, v := range s.reverseDNS {
+			if v.IP == in.IP {
+				writeError(w, http.StatusBadRequest, "a reverse DNS record already exists for this ip")
+				return
+			}
+		}
+
+		subdomain := in.Subdomain
+		if subdomain == "" {
+			subdomain = "o1.email"
+		}
+		id := s.nextID()
+		fqdn := subdomain + "." + in.Domain
+		rd := &reverseDNS{sendgrid.OutputGetReverseDNS{
+			ID:        id,
+			IP:        in.IP,
+			RDNS:      fqdn,
+			Users:     []*sendgrid.User{{Username: OwnerUsername, UserID: s.ownerID}},
+			Subdomain: subdomain,
+			Domain:    in.Domain,
+			ARecord: sendgrid.ARecord{
+				Type: "a",
+				Host: fqdn,
+				Data: in.IP,
+			},
+		}}
+		s.reverseDNS[id] = rd
+
+		writeJSON(w, http.StatusCreated, rd.OutputGetReverseDNS)
+	})
+
+	s.handle("GET /v3/whitelabel/ips/{id}", func(w http.ResponseWriter, r *http.Request) {
+		rd, ok := s.reverseDNSByPath(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, rd.OutputGetReverseDNS)
+	})
+
+	s.handle("DELETE /v3/whitelabel/ips/{id}", func(w http.ResponseWriter, r *http.Request) {
+		rd, ok := s.reverseDNSByPath(w, r)
+		if !ok {
+			return
+		}
+		delete(s.reverseDNS, rd.ID)
+		writeNoContent(w)
+	})
+
+	s.handle("POST /v3/whitelabel/ips/{id}/validate", func(w http.ResponseWriter, r *http.Request) {
+		rd, ok := s.reverseDNSByPath(w, r)
+		if !ok {
+			return
+		}
+		rd.ARecord.Valid = s.dnsPublished(rd.ARecord.Host)
+		rd.Valid = rd.ARecord.Valid
+		rd.LastValidationAttemptAt = now()
+
+		result := sendgrid.ARecordValidationResults{Valid: rd.Valid}
+		if !rd.Valid {
+			result.Reason = dnsReason(rd.ARecord.Type, rd.ARecord.Host, rd.ARecord.Data)
+		}
+		writeJSON(w, http.StatusOK, sendgrid.OutputValidateReverseDNS{
+			ID:    rd.ID,
+			Valid: rd.Valid,
+			ValidationResults: sendgrid.ValidationResultsReverseDNS{
+				ARecordValidationResults: result,
+			},
+		})
+	})
+}
+
+func (s *Server) reverseDNSByPath(w http.ResponseWriter, r *http.Request) (*reverseDNS, bool) {
+	id, ok := pathInt64(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	rd, ok := s.reverseDNS[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return rd, true
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"net/http"
+	"slices"
+)
+
+// DefaultScopes are the scopes of the API key the server is called with,
+// unless they are changed with SetScopes. Like those of a full access API key,
+// they include the scopes SendGrid adds to every API key and teammate.
+var DefaultScopes = []string{
+	"2fa_exempt",
+	"2fa_required",
+	"access_settings.activity.read",
+	"access_settings.whitelist.create",
+	"access_settings.whitelist.delete",
+	"access_settings.whitelist.read",
+	"access_settings.whitelist.update",
+	"alerts.create",
+	"alerts.delete",
+	"alerts.read",
+	"alerts.update",
+	"api_keys.create",
+	"api_keys.delete",
+	"api_keys.read",
+	"api_keys.update",
+	"asm.groups.create",
+	"asm.groups.delete",
+	"asm.groups.read",
+	"asm.groups.update",
+	"billing.read",
+	"billing.update",
+	"browsers.stats.read",
+	"categories.create",
+	"categories.delete",
+	"categories.read",
+	"categories.stats.read",
+	"categories.update",
+	"clients.stats.read",
+	"design_library.create",
+	"design_library.delete",
+	"design_library.read",
+	"design_library.update",
+	"devices.stats.read",
+	"email_activity.read",
+	"geo.stats.read",
+	"ips.assigned.read",
+	"ips.pools.create",
+	"ips.pools.delete",
+	"ips.pools.read",
+	"ips.pools.update",
+	"ips.read",
+	"mail.batch.create",
+	"mail.batch.delete",
+	"mail.batch.read",
+	"mail.batch.update",
+	"mail.send",
+	"mail_settings.address_whitelist.read",
+	"mail_settings.address_whitelist.update",
+	"mail_settings.bounce_purge.read",
+	"mail_settings.bounce_purge.update",
+	"mail_settings.footer.read",
+	"mail_settings.footer.update",
+	"mail_settings.forward_bounce.read",
+	"mail_settings.forward_bounce.update",
+	"mail_settings.forward_spam.read",
+	"mail_settings.forward_spam.update",
+	"mail_settings.plain_content.read",
+	"mail_settings.plain_content.update",
+	"mail_settings.read",
+	"mail_settings.template.read",
+	"mail_settings.template.update",
+	"mailbox_providers.stats.read",
+	"marketing.automation.read",
+	"marketing.read",
+	"marketing_campaigns.create",
+	"marketing_campaigns.delete",
+	"marketing_campaigns.read",
+	"marketing_campaigns.update",
+	"partner_settings.new_relic.read",
+	"partner_settings.new_relic.update",
+	"partner_settings.read",
+	"sender_verification_eligible",
+	"sender_verification_exempt",
+	"sso.settings.create",
+	"sso.settings.delete",
+	"sso.settings.read",
+	"sso.settings.update",
+	"sso.teammates.create",
+	"sso.teammates.update",
+	"stats.global.read",
+	"stats.read",
+	"subusers.create",
+	"subusers.credits.create",
+	"subusers.credits.delete",
+	"subusers.credits.read",
+	"subusers.credits.update",
+	"subusers.delete",
+	"subusers.monitor.create",
+	"subusers.monitor.delete",
+	"subusers.monitor.read",
+	"subusers.monitor.update",
+	"subusers.read",
+	"subusers.stats.read",
+	"subusers.update",
+	"suppression.blocks.create",
+	"suppression.blocks.delete",
+	"suppression.blocks.read",
+	"suppression.bounces.create",
+	"suppression.bounces.delete",
+	"suppression.bounces.read",
+	"suppression.create",
+	"suppression.delete",
+	"suppression.invalid_emails.create",
+	"suppression.invalid_emails.delete",
+	"suppression.invalid_emails.read",
+	"suppression.read",
+	"suppression.spam_reports.create",
+	"suppression.spam_reports.delete",
+	"suppression.spam_reports.read",
+	"suppression.unsubscribes.create",
+	"suppression.unsubscribes.delete",
+	"suppression.unsubscribes.read",
+	"teammates.create",
+	"teammates.delete",
+	"teammates.read",
+	"teammates.update",
+	"templates.create",
+	"templates.delete",
+	"templates.read",
+	"templates.update",
+	"templates.versions.activate.create",
+	"templates.versions.create",
+	"templates.versions.delete",
+	"templates.versions.read",
+	"templates.versions.update",
+	"tracking_settings.click.read",
+	"tracking_settings.click.update",
+	"tracking_settings.google_analytics.read",
+	"tracking_settings.google_analytics.update",
+	"tracking_settings.open.read",
+	"tracking_settings.open.update",
+	"tracking_settings.read",
+	"tracking_settings.subscription.read",
+	"tracking_settings.subscription.update",
+	"user.account.read",
+	"user.credits.read",
+	"user.email.create",
+	"user.email.delete",
+	"user.email.read",
+	"user.email.update",
+	"user.multifactor_authentication.create",
+	"user.multifactor_authentication.delete",
+	"user.multifactor_authentication.read",
+	"user.multifactor_authentication.update",
+	"user.password.update",
+	"user.profile.read",
+	"user.profile.update",
+	"user.scheduled_sends.create",
+	"user.scheduled_sends.delete",
+	"user.scheduled_sends.read",
+	"user.scheduled_sends.update",
+	"user.settings.enforced_tls.read",
+	"user.settings.enforced_tls.update",
+	"user.timezone.read",
+	"user.timezone.update",
+	"user.username.read",
+	"user.username.update",
+	"user.webhooks.event.settings.read",
+	"user.webhooks.event.settings.update",
+	"user.webhooks.event.test.create",
+	"user.webhooks.parse.settings.create",
+	"user.webhooks.parse.settings.delete",
+	"user.webhooks.parse.settings.read",
+	"user.webhooks.parse.settings.update",
+	"user.webhooks.parse.stats.read",
+	"whitelabel.create",
+	"whitelabel.delete",
+	"whitelabel.read",
+	"whitelabel.update",
+}
+
+// SetScopes changes the scopes of the API key the server is called with, as
+// reported by GET /v3/scopes. Without any scope, they are reset to
+// DefaultScopes.
+func (s *Server) SetScopes(scopes ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(scopes) == 0 {
+		scopes = DefaultScopes
+	}
+	s.scopes = slices.Clone(scopes)
+}
+
+func (s *Server) routeScopes() {
+	s.handle("GET /v3/scopes", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{"scopes": s.scopes})
+	})
+}
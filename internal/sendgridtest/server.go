@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sendgridtest provides an in-process, stateful stand-in for the
+// SendGrid v3 API so that the provider can be exercised without a real
+// SendGrid account.
+//
+// The server speaks the same JSON shapes as the github.com/kenzo0107/sendgrid
+// client, keeps every object it is given in memory, and answers unknown
+// objects with the same 404 error body the real API uses. Point the provider
+// at it with the base_url attribute or the SENDGRID_BASE_URL environment
+// variable:
+//
+//	s := sendgridtest.NewServer()
+//	defer s.Close()
+//	os.Setenv("SENDGRID_BASE_URL", s.BaseURL())
+package sendgridtest
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultIP is the IP address that every new server assigns to the account.
+// Resources such as subusers and IP pools only accept IP addresses the
+// account owns, so tests should use this value unless they add their own via
+// AddIP.
+const DefaultIP = "192.0.2.1"
+
+// Request describes a request received by the server.
+type Request struct {
+	Method     string
+	Path       string
+	RawQuery   string
+	OnBehalfOf string
+}
+
+// Server is a fake SendGrid v3 API.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	mux      *http.ServeMux
+	seq      int64
+	requests []Request
+	failures map[string]int
+
+	ips          []string
+	ownerID      int64
+	publishedDNS map[string]bool
+	scopes       []string
+
+	teammates        map[string]*teammate
+	pendingTeammates map[string]*pendingTeammate
+	accessRequests   map[int64]*accessRequest
+	apiKeys          map[string]*apiKey
+	subusers         map[string]*subuser
+	domains          map[int64]*domain
+	links            map[int64]*link
+	reverseDNS       map[int64]*reverseDNS
+	templates        map[string]*template
+	verifiedSenders  map[int64]*verifiedSender
+	asmGroups        map[int64]*asmGroup
+	eventWebhooks    map[string]*eventWebhook
+	parseWebhooks    map[string]*parseWebhook
+	ipPools          map[string]*ipPool
+	alerts           map[int64]*alert
+	designs          map[string]*design
+	ssoIntegrations  map[string]*ssoIntegration
+	ssoCertificates  map[int64]*ssoCertificate
+
+	globalUnsubscribes map[string]int64
+	allowedIPs         map[int64]*allowedIP
+	accessActivity     map[string]*accessActivity
+	bounces            []bounce
+	blocks             []block
+	spamReports        []spamReport
+	invalidEmails      []invalidEmail
+
+	enforcedTLS   enforcedTLS
+	bouncePurge   bouncePurge
+	clickTracking clickTracking
+	openTracking  openTracking
+	mailSettings  map[string]map[string]any
+
+	subscriptionTracking subscriptionTracking
+	googleAnalytics      googleAnalytics
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		mux:              http.NewServeMux(),
+		failures:         map[string]int{},
+		ips:              []string{DefaultIP},
+		scopes:           slices.Clone(DefaultScopes),
+		publishedDNS:     map[string]bool{},
+		teammates:        map[string]*teammate{},
+		pendingTeammates: map[string]*pendingTeammate{},
+		accessRequests:   map[int64]*accessRequest{},
+		apiKeys:          map[string]*apiKey{},
+		subusers:         map[string]*subuser{},
+		domains:          map[int64]*domain{},
+		links:            map[int64]*link{},
+		reverseDNS:       map[int64]*reverseDNS{},
+		templates:        map[string]*template{},
+		verifiedSenders:  map[int64]*verifiedSender{},
+		asmGroups:        map[int64]*asmGroup{},
+		eventWebhooks:    map[string]*eventWebhook{},
+		parseWebhooks:    map[string]*parseWebhook{},
+		ipPools:          map[string]*ipPool{},
+		alerts:           map[int64]*alert{},
+		designs:          map[string]*design{},
+		ssoIntegrations:  map[string]*ssoIntegration{},
+		ssoCertificates:  map[int64]*ssoCertificate{},
+		mailSettings:     newMailSettings(),
+
+		globalUnsubscribes: map[string]int64{},
+		allowedIPs:         map[int64]*allowedIP{},
+		accessActivity:     map[string]*accessActivity{},
+	}
+
+	s.seedAccount()
+
+	s.routeTeammates()
+	s.routeAPIKeys()
+	s.routeSubusers()
+	s.routeDomains()
+	s.routeLinks()
+	s.routeReverseDNS()
+	s.routeTemplates()
+	s.routeVerifiedSenders()
+	s.routeASMGroups()
+	s.routeSuppressions()
+	s.routeWebhooks()
+	s.routeIPPools()
+	s.routeAlerts()
+	s.routeDesigns()
+	s.routeSettings()
+	s.routeMailSettings()
+	s.routeSSO()
+	s.routeIPAccess()
+	s.routeScopes()
+	s.routeAccessRequests()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// BaseURL returns the URL to configure as the provider's base_url, including
+// the /v3 path prefix.
+func (s *Server) BaseURL() string {
+	return s.URL + "/v3"
+}
+
+// AddIP assigns an additional IP address to the account.
+func (s *Server) AddIP(ip string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !slices.Contains(s.ips, ip) {
+		s.ips = append(s.ips, ip)
+	}
+}
+
+// FailNext makes the next request with method to path, such as
+// "/v3/subusers/example/website_access", fail with 400 Bad Request. As the
+// error is not retried, it lets tests stop an operation halfway through.
+func (s *Server) FailNext(method, path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[method+" "+path]++
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.requests)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method:     r.Method,
+		Path:       r.URL.Path,
+		RawQuery:   r.URL.RawQuery,
+		OnBehalfOf: r.Header.Get("On-Behalf-Of"),
+	})
+	key := r.Method + " " + r.URL.Path
+	fail := s.failures[key] > 0
+	if fail {
+		s.failures[key]--
+	}
+	s.mu.Unlock()
+
+	if fail {
+		writeError(w, http.StatusBadRequest, "injected failure")
+		return
+	}
+
+	if strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer")) == "" {
+		writeError(w, http.StatusUnauthorized, "authorization required")
+		return
+	}
+
+	// Like SendGrid, requests from IPs missing from a non-empty IP access
+	// allow list are refused.
+	s.mu.Lock()
+	allowed := s.recordAccess(r)
+	s.mu.Unlock()
+	if !allowed {
+		writeError(w, http.StatusForbidden, "access forbidden")
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// handle registers a handler that runs with the server state locked.
+func (s *Server) handle(pattern string, h http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		h(w, r)
+	})
+}
+
+// nextID returns a new, monotonically increasing identifier.
+func (s *Server) nextID() int64 {
+	s.seq++
+	return s.seq
+}
+
+// nextUUID returns a new identifier shaped like the UUIDs SendGrid uses.
+// Identifiers sort in creation order.
+func (s *Server) nextUUID() string {
+	return fmt.Sprintf("00000000-0000-4000-8000-%012x", s.nextID())
+}
+
+func (s *Server) hasIP(ip string) bool {
+	return slices.Contains(s.ips, ip)
+}
+
+func now() int64 {
+	return time.Now().Unix()
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format("2006-01-02 15:04:05")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError responds with the error body SendGrid uses for most endpoints.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"errors": []map[string]any{
+			{"field": nil, "message": message},
+		},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "resource not found")
+}
+
+func writeNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decode reads the JSON request body into v. It responds with 400 and
+// returns false when the body cannot be decoded.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+// pathInt64 parses the named path wildcard. It responds with 404 and returns
+// false when the value is not an integer.
+func pathInt64(w http.ResponseWriter, r *http.Request, name string) (int64, bool) {
+	v, err := strconv.ParseInt(r.PathValue(name), 10, 64)
+	if err != nil {
+		writeNotFound(w)
+		return 0, false
+	}
+	return v, true
+}
+
+// queryInt returns the named query parameter as an integer, or def when it
+// is missing or malformed.
+func queryInt(r *http.Request, name string, def int) int {
+	v, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// sorted returns the values of m ordered by key.
+func sorted[K cmp.Ordered, V any](m map[K]V) []V {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	vs := make([]V, 0, len(keys))
+	for _, k := range keys {
+		vs = append(vs, m[k])
+	}
+	return vs
+}
+
+// paginate applies limit and offset query parameters to items.
+func paginate[T any](r *http.Request, items []T) []T {
+	offset := queryInt(r, "offset", 0)
+	limit := queryInt(r, "limit", 0)
+
+	if offset >= len(items) {
+		return []T{}
+	}
+	items = items[offset:]
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
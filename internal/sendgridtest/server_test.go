@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kenzo0107/sendgrid"
+	"github.com/kenzo0107/terraform-provider-sendgrid/internal/sendgridtest"
+)
+
+func newClient(t *testing.T, opts ...sendgrid.Option) (*sendgridtest.Server, *sendgrid.Client) {
+	t.Helper()
+
+	s := sendgridtest.NewServer()
+	t.Cleanup(s.Close)
+
+	opts = append([]sendgrid.Option{sendgrid.OptionBaseURL(s.BaseURL())}, opts...)
+	return s, sendgrid.New("SG.test", opts...)
+}
+
+func TestServerRequiresAuthorization(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newClient(t)
+	client := sendgrid.New("", sendgrid.OptionBaseURL(s.BaseURL()))
+
+	if _, err := client.GetAPIKeys(context.Background()); err == nil {
+		t.Fatal("expected an error without an API key")
+	}
+}
+
+func TestServerNotFound(t *testing.T) {
+	t.Parallel()
+
+	_, client := newClient(t)
+
+	_, err := client.GetAPIKey(context.Background(), "missing")
+	if err == nil || !strings.Contains(err.Error(), "resource not found") {
+		t.Fatalf("got %v, want resource not found", err)
+	}
+}
+
+func TestServerAPIKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, client := newClient(t)
+
+	created, err := client.CreateAPIKey(ctx, &sendgrid.InputCreateAPIKey{
+		Name:   "test",
+		Scopes: []string{"mail.send"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ApiKey == "" {
+		t.Error("expected the key to be returned on create")
+	}
+
+	got, err := client.GetAPIKey(ctx, created.ApiKeyId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "test" {
+		t.Errorf("name = %q, want %q", got.Name, "test")
+	}
+
+	if err := client.DeleteAPIKey(ctx, created.ApiKeyId); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetAPIKey(ctx, created.ApiKeyId); err == nil {
+		t.Error("expected the key to be gone after delete")
+	}
+}
+
+func TestServerTeammateInvitation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s, client := newClient(t)
+
+	if _, err := client.InviteTeammate(ctx, &sendgrid.InputInviteTeammate{
+		Email:  "new@example.com",
+		Scopes: []string{"user.profile.read"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := client.GetPendingTeammates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending.PendingTeammates) != 1 {
+		t.Fatalf("got %d pending teammates, want 1", len(pending.PendingTeammates))
+	}
+
+	if err := s.AcceptInvitation("new@example.com", "new"); err != nil {
+		t.Fatal(err)
+	}
+
+	teammate, err := client.GetTeammate(ctx, "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if teammate.Email != "new@example.com" {
+		t.Errorf("email = %q, want %q", teammate.Email, "new@example.com")
+	}
+}
+
+func TestServerTeammateInvitationExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s, client := newClient(t)
+
+	invited, err := client.InviteTeammate(ctx, &sendgrid.InputInviteTeammate{
+		Email:  "late@example.com",
+		Scopes: []string{"user.profile.read"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.ExpireInvitation("late@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AcceptInvitation("late@example.com", "late"); err == nil {
+		t.Fatal("expected an expired invitation not to be accepted")
+	}
+
+	req, err := client.NewRequest("POST", "/teammates/pending/"+invited.Token+"/resend", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Do(ctx, req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.AcceptInvitation("late@example.com", "late"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServerAccessRequestApproval(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s, client := newClient(t)
+
+	if _, err := client.InviteTeammate(ctx, &sendgrid.InputInviteTeammate{
+		Email:  "curious@example.com",
+		Scopes: []string{"user.profile.read"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AcceptInvitation("curious@example.com", "curious"); err != nil {
+		t.Fatal(err)
+	}
+	id, err := s.RequestAccess("curious", "Mail Send")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("/scopes/requests/%d/approve", id), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Do(ctx, req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	teammate, err := client.GetTeammate(ctx, "curious")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(teammate.Scopes, "mail.send") {
+		t.Errorf("expected the approval to grant mail.send, got %v", teammate.Scopes)
+	}
+
+	// A decided request is no longer open.
+	if err := client.Do(ctx, req, nil); err == nil {
+		t.Error("expected a decided request not to be approved again")
+	}
+}
+
+func TestServerSubuserRequiresOwnedIP(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, client := newClient(t)
+
+	input := &sendgrid.InputCreateSubuser{
+		Username: "sub",
+		Email:    "sub@example.com",
+		Password: "password",
+		Ips:      []string{"198.51.100.1"},
+	}
+	if _, err := client.CreateSubuser(ctx, input); err == nil {
+		t.Fatal("expected an error for an IP the account does not own")
+	}
+
+	input.Ips = []string{sendgridtest.DefaultIP}
+	if _, err := client.CreateSubuser(ctx, input); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServerDomainValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s, client := newClient(t)
+
+	domain, err := client.AuthenticateDomain(ctx, &sendgrid.InputAuthenticateDomain{
+		Domain:            "example.com",
+		AutomaticSecurity: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.ValidateDomainAuthentication(ctx, domain.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Valid {
+		t.Fatal("expected validation to fail before the records are published")
+	}
+
+	for _, r := range []sendgrid.Record{domain.DNS.MailCname, domain.DNS.Dkim1, domain.DNS.Dkim2} {
+		s.PublishDNSRecord(r.Host)
+	}
+
+	result, err = client.ValidateDomainAuthentication(ctx, domain.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Valid {
+		t.Errorf("expected validation to pass, got %+v", result.ValidationResults)
+	}
+}
+
+func TestServerRecordsOnBehalfOf(t *testing.T) {
+	t.Parallel()
+
+	s, client := newClient(t, sendgrid.OptionSubuser("sub"))
+
+	if _, err := client.GetAPIKeys(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := s.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+	if requests[0].OnBehalfOf != "sub" {
+		t.Errorf("On-Behalf-Of = %q, want %q", requests[0].OnBehalfOf, "sub")
+	}
+}
+
+func TestServerIPAccessAllowList(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, client := newClient(t)
+
+	added, err := client.AddIPsToAllowList(ctx, &sendgrid.InputAddIPsToAllowList{
+		IPs: []sendgrid.AllowListIP{{IP: "127.0.0.0/8"}, {IP: "192.0.2.1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	activity, err := client.GetAccessActivities(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(activity.Result) != 1 || activity.Result[0].IP != "127.0.0.1" || !activity.Result[0].Allowed {
+		t.Errorf("expected one allowed access from 127.0.0.1, got %+v", activity.Result)
+	}
+
+	// Removing the entry that covers the test client locks it out.
+	var id int64
+	for _, a := range added.Result {
+		if a.IP == "127.0.0.0/8" {
+			id = a.ID
+		}
+	}
+	if err := client.RemoveIPFromAllowList(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetAllowedIPs(ctx); err == nil || !strings.Contains(err.Error(), "access forbidden") {
+		t.Fatalf("got %v, want access forbidden", err)
+	}
+}
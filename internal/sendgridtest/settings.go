@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"net/http"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type (
+	enforcedTLS   = sendgrid.OutputGetEnforceTLS
+	bouncePurge   = sendgrid.OutputGetBounceSettings
+	clickTracking = sendgrid.OutputGetClickTrackingSettings
+	openTracking  = sendgrid.OutputGetOpenTrackingSettings
+
+	subscriptionTracking = sendgrid.OutputGetSubscriptionTrackingSettings
+	googleAnalytics      = sendgrid.OutputGetGoogleAnalyticsSettings
+)
+
+func (s *Server) routeSettings() {
+	s.handle("GET /v3/user/settings/enforced_tls", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, s.enforcedTLS)
+	})
+
+	s.handle("PATCH /v3/user/settings/enforced_tls", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputUpdateEnforceTLS
+		if !decode(w, r, &in) {
+			return
+		}
+		switch in.Version {
+		case 0:
+		case 1.1, 1.2, 1.3:
+			s.enforcedTLS.Version = in.Version
+		default:
+			writeError(w, http.StatusBadRequest, "version must be one of 1.1, 1.2 or 1.3")
+			return
+		}
+		s.enforcedTLS.RequireTLS = in.RequireTLS
+		s.enforcedTLS.RequireValidCert = in.RequireValidCert
+		writeJSON(w, http.StatusOK, s.enforcedTLS)
+	})
+
+	s.handle("GET /v3/mail_settings/bounce_purge", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, s.bouncePurge)
+	})
+
+	s.handle("PATCH /v3/mail_settings/bounce_purge", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputUpdateBounceSettings
+		if !decode(w, r, &in) {
+			return
+		}
+		s.bouncePurge.Enabled = in.Enabled
+		if in.SoftBounces != 0 {
+			s.bouncePurge.SoftBounces = in.SoftBounces
+		}
+		if in.HardBounces != 0 {
+			s.bouncePurge.HardBounces = in.HardBounces
+		}
+		writeJSON(w, http.StatusOK, s.bouncePurge)
+	})
+
+	s.handle("GET /v3/tracking_settings/click", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, s.clickTracking)
+	})
+
+	s.handle("PATCH /v3/tracking_settings/click", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputUpdateClickTrackingSettings
+		if !decode(w, r, &in) {
+			return
+		}
+		s.clickTracking.Enabled = in.Enabled
+		writeJSON(w, http.StatusOK, s.clickTracking)
+	})
+	s.handle("GET /v3/tracking_settings/open", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, s.openTracking)
+	})
+
+	s.handle("PATCH /v3/tracking_settings/open", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputUpdateOpenTrackingSettings
+		if !decode(w, r, &in) {
+			return
+		}
+		s.openTracking.Enabled = in.Enabled
+		writeJSON(w, http.StatusOK, s.openTracking)
+	})
+
+	s.handle("GET /v3/tracking_settings/subscription", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, s.subscriptionTracking)
+	})
+
+	// Like SendGrid, PATCH leaves the fields missing from the body unchanged.
+	s.handle("PATCH /v3/tracking_settings/subscription", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputUpdateSubscriptionTrackingSettings
+		if !decode(w, r, &in) {
+			return
+		}
+		s.subscriptionTracking.Enabled = in.Enabled
+		setIfNotEmpty(&s.subscriptionTracking.HTMLContent, in.HTMLContent)
+		setIfNotEmpty(&s.subscriptionTracking.PlainContent, in.PlainContent)
+		setIfNotEmpty(&s.subscriptionTracking.Landing, in.Landing)
+		setIfNotEmpty(&s.subscriptionTracking.Replace, in.Replace)
+		setIfNotEmpty(&s.subscriptionTracking.URL, in.URL)
+		writeJSON(w, http.StatusOK, s.subscriptionTracking)
+	})
+
+	s.handle("GET /v3/tracking_settings/google_analytics", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, s.googleAnalytics)
+	})
+
+	s.handle("PATCH /v3/tracking_settings/google_analytics", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputUpdateGoogleAnalyticsSettings
+		if !decode(w, r, &in) {
+			return
+		}
+		s.googleAnalytics.Enabled = in.Enabled
+		setIfNotEmpty(&s.googleAnalytics.UTMSource, in.UTMSource)
+		setIfNotEmpty(&s.googleAnalytics.UTMMedium, in.UTMMedium)
+		setIfNotEmpty(&s.googleAnalytics.UTMTerm, in.UTMTerm)
+		setIfNotEmpty(&s.googleAnalytics.UTMContent, in.UTMContent)
+		setIfNotEmpty(&s.googleAnalytics.UTMCampaign, in.UTMCampaign)
+		writeJSON(w, http.StatusOK, s.googleAnalytics)
+	})
+}
+
+func setIfNotEmpty(dst *string, v string) {
+	if v != "" {
+		*dst = v
+	}
+}
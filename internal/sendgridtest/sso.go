@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type ssoIntegration struct {
+	sendgrid.SSOIntegration
+}
+
+type ssoCertificate struct {
+	sendgrid.SSOCertificate
+}
+
+func (s *Server) routeSSO() {
+	s.handle("GET /v3/sso/integrations", func(w http.ResponseWriter, r *http.Request) {
+		out := []*sendgrid.SSOIntegration{}
+		for _, i := range sorted(s.ssoIntegrations) {
+			v := i.SSOIntegration
+			out = append(out, &v)
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/sso/integrations", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateSSOIntegration
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name == "" {
+			writeError(w, http.StatusBadRequest, "name is required")
+			return
+		}
+
+		id := s.nextUUID()
+		i := &ssoIntegration{sendgrid.SSOIntegration{
+			ID:                   id,
+			Name:                 in.Name,
+			Enabled:              in.Enabled,
+			SigninURL:            in.SigninURL,
+			SignoutURL:           in.SignoutURL,
+			EntityID:             in.EntityID,
+			CompletedIntegration: in.CompletedIntegration,
+			LastUpdated:          now(),
+			SingleSignonURL:      fmt.Sprintf("https://api.sendgrid.com/v3/public/sso/saml/response/id/%s", id),
+			AudienceURL:          fmt.Sprintf("https://api.sendgrid.com/v3/public/sso/saml/response/id/%s", id),
+		}}
+		s.ssoIntegrations[id] = i
+
+		writeJSON(w, http.StatusCreated, i.SSOIntegration)
+	})
+
+	s.handle("GET /v3/sso/integrations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		i, ok := s.ssoIntegrations[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, i.SSOIntegration)
+	})
+
+	s.handle("PATCH /v3/sso/integrations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		i, ok := s.ssoIntegrations[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateSSOIntegration
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name != "" {
+			i.Name = in.Name
+		}
+		if in.SigninURL != "" {
+			i.SigninURL = in.SigninURL
+		}
+		if in.SignoutURL != "" {
+			i.SignoutURL = in.SignoutURL
+		}
+		if in.EntityID != "" {
+			i.EntityID = in.EntityID
+		}
+		i.Enabled = in.Enabled
+		i.CompletedIntegration = in.CompletedIntegration
+		i.LastUpdated = now()
+		writeJSON(w, http.StatusOK, i.SSOIntegration)
+	})
+
+	s.handle("DELETE /v3/sso/integrations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.ssoIntegrations[id]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.ssoIntegrations, id)
+		for cid, c := range s.ssoCertificates {
+			if c.IntegrationID == id {
+				delete(s.ssoCertificates, cid)
+			}
+		}
+		writeNoContent(w)
+	})
+
+	s.handle("GET /v3/sso/integrations/{id}/certificates", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.ssoIntegrations[id]; !ok {
+			writeNotFound(w)
+			return
+		}
+		out := []*sendgrid.SSOCertificate{}
+		for _, c := range sorted(s.ssoCertificates) {
+			if c.IntegrationID == id {
+				v := c.SSOCertificate
+				out = append(out, &v)
+			}
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/sso/certificates", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateSSOCertificate
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.PublicCertificate == "" {
+			writeError(w, http.StatusBadRequest, "public_certificate is required")
+			return
+		}
+		if _, ok := s.ssoIntegrations[in.IntegrationID]; !ok {
+			writeError(w, http.StatusBadRequest, "integration_id does not exist")
+			return
+		}
+
+		t := time.Now()
+		c := &ssoCertificate{sendgrid.SSOCertificate{
+			ID:                s.nextID(),
+			PublicCertificate: in.PublicCertificate,
+			NotBefore:         t.Unix(),
+			NotAfter:          t.AddDate(1, 0, 0).Unix(),
+			IntegrationID:     in.IntegrationID,
+		}}
+		s.ssoCertificates[c.ID] = c
+
+		writeJSON(w, http.StatusCreated, c.SSOCertificate)
+	})
+
+	s.handle("GET /v3/sso/certificates/{id}", func(w http.ResponseWriter, r *http.Request) {
+		c, ok := s.ssoCertificateByPath(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, c.SSOCertificate)
+	})
+
+	s.handle("PATCH /v3/sso/certificates/{id}", func(w http.ResponseWriter, r *http.Request) {
+		c, ok := s.ssoCertificateByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputUpdateSSOCertificate
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.PublicCertificate != "" {
+			c.PublicCertificate = in.PublicCertificate
+		}
+		if in.IntegrationID != "" {
+			if _, ok := s.ssoIntegrations[in.IntegrationID]; !ok {
+				writeError(w, http.StatusBadRequest, "integration_id does not exist")
+				return
+			}
+			c.IntegrationID = in.IntegrationID
+		}
+		writeJSON(w, http.StatusOK, c.SSOCertificate)
+	})
+
+	s.handle("DELETE /v3/sso/certificates/{id}", func(w http.ResponseWriter, r *http.Request) {
+		c, ok := s.ssoCertificateByPath(w, r)
+		if !ok {
+			return
+		}
+		delete(s.ssoCertificates, c.ID)
+		writeJSON(w, http.StatusOK, c.SSOCertificate)
+	})
+}
+
+func (s *Server) ssoCertificateByPath(w http.ResponseWriter, r *http.Request) (*ssoCertificate, bool) {
+	id, ok := pathInt64(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	c, ok := s.ssoCertificates[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return c, true
+}
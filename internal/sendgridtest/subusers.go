@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type subuser struct {
+	sendgrid.Subuser
+
+	IPs     []string
+	Credits sendgrid.OutputGetCreditsForSubuser
+
+	WebsiteAccessDisabled bool
+	Monitor               *subuserMonitor
+}
+
+type subuserMonitor struct {
+	Email     string `json:"email"`
+	Frequency int64  `json:"frequency"`
+}
+
+// UseCredits records that the subuser named username sent n emails, using up
+// n of its credits, as if SendGrid had delivered them.
+func (s *Server) UseCredits(username string, n int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	su, ok := s.subusers[username]
+	if !ok {
+		return fmt.Errorf("no subuser named %q", username)
+	}
+	su.Credits.Used += n
+	if su.Credits.Type != "unlimited" {
+		su.Credits.Remain = max(su.Credits.Remain-n, 0)
+	}
+	return nil
+}
+
+func (s *Server) invalidIP(ips []string) (string, bool) {
+	for _, ip := range ips {
+		if !s.hasIP(ip) {
+			return ip, true
+		}
+	}
+	return "", false
+}
+
+func (s *Server) routeSubusers() {
+	s.handle("GET /v3/subusers", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		out := []*sendgrid.Subuser{}
+		for _, su := range sorted(s.subusers) {
+			if username := q.Get("username"); username != "" && su.Username != username {
+				continue
+			}
+			if region := q.Get("region"); region != "" && su.Region != region {
+				continue
+			}
+			v := su.Subuser
+			if q.Get("include_region") != "true" {
+				v.Region = ""
+			}
+			out = append(out, &v)
+		}
+		writeJSON(w, http.StatusOK, paginate(r, out))
+	})
+
+	s.handle("POST /v3/subusers", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateSubuser
+		if !decode(w, r, &in) {
+			return
+		}
+		switch {
+		case in.Username == "":
+			writeError(w, http.StatusBadRequest, "username is required")
+			return
+		case in.Email == "":
+			writeError(w, http.StatusBadRequest, "email is required")
+			return
+		case in.Password == "":
+			writeError(w, http.StatusBadRequest, "password is required")
+			return
+		}
+		if _, ok := s.subusers[in.Username]; ok {
+			writeError(w, http.StatusBadRequest, "username exists")
+			return
+		}
+		if ip, ok := s.invalidIP(in.Ips); ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unable to assign ip %s", ip))
+			return
+		}
+
+		region := in.Region
+		if region == "" {
+			region = "global"
+		}
+		su := &subuser{
+			Subuser: sendgrid.Subuser{
+				ID:       s.nextID(),
+				Username: in.Username,
+				Email:    in.Email,
+				Region:   region,
+			},
+			IPs: in.Ips,
+			Credits: sendgrid.OutputGetCreditsForSubuser{
+				Type: "unlimited",
+			},
+		}
+		s.subusers[su.Username] = su
+
+		writeJSON(w, http.StatusCreated, sendgrid.OutputCreateSubuser{
+			UserID:             su.ID,
+			Username:           su.Username,
+			Email:              su.Email,
+			SignupSessionToken: "",
+			AuthorizationToken: "",
+			CreditAllocation:   sendgrid.CreditAllocation{Type: su.Credits.Type},
+			Region:             su.Region,
+		})
+	})
+
+	s.handle("GET /v3/subusers/{username}", func(w http.ResponseWriter, r *http.Request) {
+		su, ok := s.subusers[r.PathValue("username")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, su.Subuser)
+	})
+
+	s.handle("PATCH /v3/subusers/{username}", func(w http.ResponseWriter, r *http.Request) {
+		su, ok := s.subusers[r.PathValue("username")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateSubuserStatus
+		if !decode(w, r, &in) {
+			return
+		}
+		su.Disabled = in.Disabled
+		writeNoContent(w)
+	})
+
+	s.handle("DELETE /v3/subusers/{username}", func(w http.ResponseWriter, r *http.Request) {
+		username := r.PathValue("username")
+		if _, ok := s.subusers[username]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.subusers, username)
+		writeNoContent(w)
+	})
+
+	s.handle("PATCH /v3/subusers/{username}/website_access", func(w http.ResponseWriter, r *http.Request) {
+		su, ok := s.subusers[r.PathValue("username")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in struct {
+			Disabled bool `json:"disabled"`
+		}
+		if !decode(w, r, &in) {
+			return
+		}
+		su.WebsiteAccessDisabled = in.Disabled
+		writeNoContent(w)
+	})
+
+	s.handle("GET /v3/subusers/{username}/monitor", func(w http.ResponseWriter, r *http.Request) {
+		su, ok := s.subusers[r.PathValue("username")]
+		if !ok || su.Monitor == nil {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, su.Monitor)
+	})
+
+	// Like SendGrid, a monitor is created with POST and changed with PUT.
+	writeMonitor := func(create bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			su, ok := s.subusers[r.PathValue("username")]
+			if !ok {
+				writeNotFound(w)
+				return
+			}
+			if create && su.Monitor != nil {
+				writeError(w, http.StatusBadRequest, "monitor already exists")
+				return
+			}
+			if !create && su.Monitor == nil {
+				writeNotFound(w)
+				return
+			}
+			var in subuserMonitor
+			if !decode(w, r, &in) {
+				return
+			}
+			switch {
+			case in.Email == "":
+				writeError(w, http.StatusBadRequest, "email is required")
+				return
+			case in.Frequency < 1:
+				writeError(w, http.StatusBadRequest, "frequency must be a positive integer")
+				return
+			}
+			su.Monitor = &in
+			writeJSON(w, http.StatusOK, su.Monitor)
+		}
+	}
+	s.handle("POST /v3/subusers/{username}/monitor", writeMonitor(true))
+	s.handle("PUT /v3/subusers/{username}/monitor", writeMonitor(false))
+
+	s.handle("DELETE /v3/subusers/{username}/monitor", func(w http.ResponseWriter, r *http.Request) {
+		su, ok := s.subusers[r.PathValue("username")]
+		if !ok || su.Monitor == nil {
+			writeNotFound(w)
+			return
+		}
+		su.Monitor = nil
+		writeNoContent(w)
+	})
+
+	s.handle("PUT /v3/subusers/{username}/ips", func(w http.ResponseWriter, r *http.Request) {
+		su, ok := s.subusers[r.PathValue("username")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var ips []string
+		if !decode(w, r, &ips) {
+			return
+		}
+		if ip, ok := s.invalidIP(ips); ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unable to assign ip %s", ip))
+			return
+		}
+		su.IPs = ips
+		writeJSON(w, http.StatusOK, ips)
+	})
+
+	s.handle("GET /v3/subusers/{username}/credits", func(w http.ResponseWriter, r *http.Request) {
+		su, ok := s.subusers[r.PathValue("username")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, su.Credits)
+	})
+
+	s.handle("PUT /v3/subusers/{username}/credits", func(w http.ResponseWriter, r *http.Request) {
+		su, ok := s.subusers[r.PathValue("username")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateCreditsForSubuser
+		if !decode(w, r, &in) {
+			return
+		}
+		switch in.Type {
+		case "unlimited":
+			su.Credits = sendgrid.OutputGetCreditsForSubuser{Type: in.Type, Used: su.Credits.Used}
+		case "recurring", "nonrecurring":
+			if in.Type == "recurring" && in.ResetFrequency == "" {
+				writeError(w, http.StatusBadRequest, "reset_frequency is required for recurring credits")
+				return
+			}
+			su.Credits.Type = in.Type
+			su.Credits.ResetFrequency = in.ResetFrequency
+			su.Credits.Total = in.Total
+			su.Credits.Remain = max(in.Total-su.Credits.Used, 0)
+		default:
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid credit type %q", in.Type))
+			return
+		}
+		writeJSON(w, http.StatusOK, su.Credits)
+	})
+
+	s.handle("PATCH /v3/subusers/{username}/credits/remaining", func(w http.ResponseWriter, r *http.Request) {
+		su, ok := s.subusers[r.PathValue("username")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateRemainingCreditsForSubuser
+		if !decode(w, r, &in) {
+			return
+		}
+		if su.Credits.Type == "unlimited" {
+			writeError(w, http.StatusBadRequest, "cannot update remaining credits of an unlimited subuser")
+			return
+		}
+		su.Credits.Remain = max(su.Credits.Remain+in.AllocationUpdate, 0)
+		su.Credits.Total = su.Credits.Remain + su.Credits.Used
+		writeJSON(w, http.StatusOK, su.Credits)
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type (
+	bounce       = sendgrid.Bounce
+	block        = sendgrid.Block
+	spamReport   = sendgrid.SpamReport
+	invalidEmail = sendgrid.InvalidEmail
+)
+
+// AddBounce adds a bounce to the suppression list of the account, as if
+// SendGrid had recorded it. A zero Created is set to the current time.
+func (s *Server) AddBounce(b sendgrid.Bounce) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if b.Created == 0 {
+		b.Created = now()
+	}
+	s.bounces = append(s.bounces, b)
+}
+
+// AddBlock adds a block to the suppression list of the account. A zero
+// Created is set to the current time.
+func (s *Server) AddBlock(b sendgrid.Block) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if b.Created == 0 {
+		b.Created = now()
+	}
+	s.blocks = append(s.blocks, b)
+}
+
+// AddSpamReport adds a spam report to the suppression list of the account. A
+// zero Created is set to the current time.
+func (s *Server) AddSpamReport(r sendgrid.SpamReport) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Created == 0 {
+		r.Created = now()
+	}
+	s.spamReports = append(s.spamReports, r)
+}
+
+// AddInvalidEmail adds an invalid email to the suppression list of the
+// account. A zero Created is set to the current time.
+func (s *Server) AddInvalidEmail(e sendgrid.InvalidEmail) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e.Created == 0 {
+		e.Created = now()
+	}
+	s.invalidEmails = append(s.invalidEmails, e)
+}
+
+func (s *Server) routeSuppressions() {
+	s.handle("GET /v3/asm/groups/{id}/suppressions", func(w http.ResponseWriter, r *http.Request) {
+		g, ok := s.asmGroupByPath(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, sortedKeys(g.suppressions))
+	})
+
+	s.handle("POST /v3/asm/groups/{id}/suppressions", func(w http.ResponseWriter, r *http.Request) {
+		g, ok := s.asmGroupByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputAddSuppressionsToGroup
+		if !decode(w, r, &in) {
+			return
+		}
+		for _, email := range in.RecipientEmails {
+			if _, ok := g.suppressions[email]; !ok {
+				g.suppressions[email] = now()
+			}
+		}
+		writeJSON(w, http.StatusCreated, sendgrid.OutputAddSuppressionsToGroup{
+			RecipientEmails: in.RecipientEmails,
+		})
+	})
+
+	s.handle("POST /v3/asm/groups/{id}/suppressions/search", func(w http.ResponseWriter, r *http.Request) {
+		g, ok := s.asmGroupByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputSearchGroupSuppressions
+		if !decode(w, r, &in) {
+			return
+		}
+		out := []string{}
+		for _, email := range in.RecipientEmails {
+			if _, ok := g.suppressions[email]; ok {
+				out = append(out, email)
+			}
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("DELETE /v3/asm/groups/{id}/suppressions/{email}", func(w http.ResponseWriter, r *http.Request) {
+		g, ok := s.asmGroupByPath(w, r)
+		if !ok {
+			return
+		}
+		delete(g.suppressions, r.PathValue("email"))
+		writeNoContent(w)
+	})
+
+	s.handle("POST /v3/asm/suppressions/global", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputAddRecipientAddressesToGlobalSuppressions
+		if !decode(w, r, &in) {
+			return
+		}
+		for _, email := range in.RecipientEmails {
+			if _, ok := s.globalUnsubscribes[email]; !ok {
+				s.globalUnsubscribes[email] = now()
+			}
+		}
+		writeJSON(w, http.StatusCreated, sendgrid.OutputAddRecipientAddressesToGlobalSuppressions{
+			RecipientEmails: in.RecipientEmails,
+		})
+	})
+
+	// Like SendGrid, an address that is not suppressed is answered with an
+	// empty object rather than 404.
+	s.handle("GET /v3/asm/suppressions/global/{email}", func(w http.ResponseWriter, r *http.Request) {
+		email := r.PathValue("email")
+		out := sendgrid.OutputGetGlobalSuppression{}
+		if _, ok := s.globalUnsubscribes[email]; ok {
+			out.RecipientEmail = email
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("DELETE /v3/asm/suppressions/global/{email}", func(w http.ResponseWriter, r *http.Request) {
+		delete(s.globalUnsubscribes, r.PathValue("email"))
+		writeNoContent(w)
+	})
+
+	s.handle("GET /v3/suppression/unsubscribes", func(w http.ResponseWriter, r *http.Request) {
+		out := []sendgrid.GlobalUnsubscribe{}
+		for _, email := range sortedKeys(s.globalUnsubscribes) {
+			created := s.globalUnsubscribes[email]
+			if matchesSuppressionQuery(r, email, created) {
+				out = append(out, sendgrid.GlobalUnsubscribe{Email: email, Created: created})
+			}
+		}
+		writeJSON(w, http.StatusOK, paginate(r, out))
+	})
+
+	s.handle("GET /v3/suppression/bounces", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, paginate(r, filterSuppressions(r, s.bounces, func(b sendgrid.Bounce) (string, int64) {
+			return b.Email, b.Created
+		})))
+	})
+
+	s.handle("GET /v3/suppression/blocks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, paginate(r, filterSuppressions(r, s.blocks, func(b sendgrid.Block) (string, int64) {
+			return b.Email, b.Created
+		})))
+	})
+
+	s.handle("GET /v3/suppression/spam_reports", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, paginate(r, filterSuppressions(r, s.spamReports, func(sr sendgrid.SpamReport) (string, int64) {
+			return sr.Email, sr.Created
+		})))
+	})
+
+	s.handle("GET /v3/suppression/invalid_emails", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, paginate(r, filterSuppressions(r, s.invalidEmails, func(e sendgrid.InvalidEmail) (string, int64) {
+			return e.Email, e.Created
+		})))
+	})
+}
+
+// filterSuppressions returns the items that match the start_time, end_time
+// and email query parameters.
+func filterSuppressions[T any](r *http.Request, items []T, key func(T) (string, int64)) []T {
+	out := []T{}
+	for _, item := range items {
+		if email, created := key(item); matchesSuppressionQuery(r, email, created) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+func matchesSuppressionQuery(r *http.Request, email string, created int64) bool {
+	q := r.URL.Query()
+	if v, err := strconv.ParseInt(q.Get("start_time"), 10, 64); err == nil && created < v {
+		return false
+	}
+	if v, err := strconv.ParseInt(q.Get("end_time"), 10, 64); err == nil && created > v {
+		return false
+	}
+	if v := q.Get("email"); v != "" && v != email {
+		return false
+	}
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+// OwnerEmail and OwnerUsername identify the account owner every new server
+// starts with.
+const (
+	OwnerEmail    = "owner@example.com"
+	OwnerUsername = "owner"
+)
+
+type teammate struct {
+	sendgrid.Teammate
+
+	Scopes                     []string
+	IsSSO                      bool
+	HasRestrictedSubuserAccess bool
+	SubuserAccess              []sendgrid.InputSubuserAccess
+}
+
+type pendingTeammate = sendgrid.PendingTeammate
+
+// scopesBlockedDuringInvitation are refused when inviting a teammate, and can
+// only be granted once the invitation is accepted.
+var scopesBlockedDuringInvitation = []string{
+	"user.profile.update",
+	"user.password.update",
+}
+
+// invitationTTL is how long an invitation can be accepted after it is sent.
+const invitationTTL = 7 * 24 * time.Hour
+
+func (s *Server) seedAccount() {
+	s.ownerID = s.nextID()
+	s.teammates[OwnerUsername] = &teammate{
+		Teammate: sendgrid.Teammate{
+			Username: OwnerUsername,
+			Email:    OwnerEmail,
+			UserType: "owner",
+			IsAdmin:  true,
+		},
+	}
+}
+
+// AcceptInvitation turns the pending teammate invited with email into a
+// teammate named username, as if they had accepted the invitation. Like
+// SendGrid, it fails once the invitation has expired.
+func (s *Server) AcceptInvitation(email, username string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for token, p := range s.pendingTeammates {
+		if p.Email != email {
+			continue
+		}
+		if int64(p.ExpirationDate) < time.Now().Unix() {
+			return fmt.Errorf("the invitation of %q has expired", email)
+		}
+		delete(s.pendingTeammates, token)
+		s.teammates[username] = &teammate{
+			Teammate: sendgrid.Teammate{
+				Username: username,
+				Email:    p.Email,
+				UserType: userType(p.IsAdmin),
+				IsAdmin:  p.IsAdmin,
+			},
+			Scopes: p.Scopes,
+		}
+		return nil
+	}
+	return fmt.Errorf("no pending teammate with email %q", email)
+}
+
+// ExpireInvitation makes the invitation of the pending teammate invited with
+// email expire, as if it had been sent more than seven days ago.
+func (s *Server) ExpireInvitation(email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.pendingTeammateByEmail(email)
+	if p == nil {
+		return fmt.Errorf("no pending teammate with email %q", email)
+	}
+	p.ExpirationDate = int(time.Now().Add(-time.Hour).Unix())
+	return nil
+}
+
+func userType(isAdmin bool) string {
+	if isAdmin {
+		return "admin"
+	}
+	return "teammate"
+}
+
+func (s *Server) teammateByEmail(email string) *teammate {
+	for _, t := range s.teammates {
+		if t.Email == email {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Server) pendingTeammateByEmail(email string) *pendingTeammate {
+	for _, p := range s.pendingTeammates {
+		if p.Email == email {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) subuserAccess(in []sendgrid.InputSubuserAccess) []sendgrid.OutputSubuserAccess {
+	out := []sendgrid.OutputSubuserAccess{}
+	for _, a := range in {
+		o := sendgrid.OutputSubuserAccess{
+			ID:             a.ID,
+			PermissionType: a.PermissionType,
+			Scopes:         a.Scopes,
+		}
+		for _, su := range s.subusers {
+			if su.ID == a.ID {
+				o.Username = su.Username
+				o.Email = su.Email
+				o.Disabled = su.Disabled
+			}
+		}
+		out = append(out, o)
+	}
+	return out
+}
+
+func (s *Server) routeTeammates() {
+	s.handle("GET /v3/teammates", func(w http.ResponseWriter, r *http.Request) {
+		out := sendgrid.OutputGetTeammates{Teammates: []sendgrid.Teammate{}}
+		for _, t := range paginate(r, sorted(s.teammates)) {
+			out.Teammates = append(out.Teammates, t.Teammate)
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/teammates", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputInviteTeammate
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Email == "" {
+			writeError(w, http.StatusBadRequest, "email is required")
+			return
+		}
+		if s.teammateByEmail(in.Email) != nil || s.pendingTeammateByEmail(in.Email) != nil {
+			writeError(w, http.StatusBadRequest, "email already exists")
+			return
+		}
+		for _, scope := range in.Scopes {
+			if slices.Contains(scopesBlockedDuringInvitation, scope) {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("scope %s cannot be assigned when inviting a teammate", scope))
+				return
+			}
+		}
+
+		token := fmt.Sprintf("%032x", s.nextID())
+		s.pendingTeammates[token] = &pendingTeammate{
+			Email:          in.Email,
+			Scopes:         in.Scopes,
+			IsAdmin:        in.IsAdmin,
+			Token:          token,
+			ExpirationDate: int(time.Now().Add(invitationTTL).Unix()),
+		}
+		writeJSON(w, http.StatusCreated, sendgrid.OutputInviteTeammate{
+			Token:   token,
+			Email:   in.Email,
+			IsAdmin: in.IsAdmin,
+			Scopes:  in.Scopes,
+		})
+	})
+
+	s.handle("GET /v3/teammates/pending", func(w http.ResponseWriter, r *http.Request) {
+		out := sendgrid.OutputGetPendingTeammates{PendingTeammates: []sendgrid.PendingTeammate{}}
+		for _, p := range sorted(s.pendingTeammates) {
+			out.PendingTeammates = append(out.PendingTeammates, *p)
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/teammates/pending/{token}/resend", func(w http.ResponseWriter, r *http.Request) {
+		p, ok := s.pendingTeammates[r.PathValue("token")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		// Resending an invitation, expired or not, extends it.
+		p.ExpirationDate = int(time.Now().Add(invitationTTL).Unix())
+		writeJSON(w, http.StatusOK, sendgrid.OutputInviteTeammate{
+			Token:   p.Token,
+			Email:   p.Email,
+			IsAdmin: p.IsAdmin,
+			Scopes:  p.Scopes,
+		})
+	})
+
+	s.handle("DELETE /v3/teammates/pending/{token}", func(w http.ResponseWriter, r *http.Request) {
+		token := r.PathValue("token")
+		if _, ok := s.pendingTeammates[token]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.pendingTeammates, token)
+		writeNoContent(w)
+	})
+
+	s.handle("GET /v3/teammates/{username}", func(w http.ResponseWriter, r *http.Request) {
+		t, ok := s.teammates[r.PathValue("username")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, sendgrid.OutputGetTeammate{
+			Username:  t.Username,
+			FirstName: t.FirstName,
+			LastName:  t.LastName,
+			Email:     t.Email,
+			Scopes:    t.Scopes,
+			UserType:  t.UserType,
+			IsAdmin:   t.IsAdmin,
+		})
+	})
+
+	s.handle("PATCH /v3/teammates/{username}", func(w http.ResponseWriter, r *http.Request) {
+		t, ok := s.teammates[r.PathValue("username")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateTeammatePermissions
+		if !decode(w, r, &in) {
+			return
+		}
+		t.IsAdmin = in.IsAdmin
+		t.Scopes = in.Scopes
+		if t.UserType != "owner" {
+			t.UserType = userType(in.IsAdmin)
+		}
+		writeJSON(w, http.StatusOK, sendgrid.OutputUpdateTeammatePermissions{
+			Username:  t.Username,
+			FirstName: t.FirstName,
+			LastName:  t.LastName,
+			Email:     t.Email,
+			Scopes:    t.Scopes,
+			UserType:  t.UserType,
+			IsAdmin:   t.IsAdmin,
+		})
+	})
+
+	s.handle("DELETE /v3/teammates/{username}", func(w http.ResponseWriter, r *http.Request) {
+		username := r.PathValue("username")
+		t, ok := s.teammates[username]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		if t.UserType == "owner" {
+			writeError(w, http.StatusBadRequest, "the account owner cannot be deleted")
+			return
+		}
+		delete(s.teammates, username)
+		for id, a := range s.accessRequests {
+			if a.Username == username {
+				delete(s.accessRequests, id)
+			}
+		}
+		writeNoContent(w)
+	})
+
+	s.handle("GET /v3/teammates/{username}/subuser_access", func(w http.ResponseWriter, r *http.Request) {
+		t, ok := s.teammates[r.PathValue("username")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		out := sendgrid.OutputGetTeammateSubuserAccess{
+			HasRestrictedSubuserAccess: t.HasRestrictedSubuserAccess,
+			SubuserAccess:              []sendgrid.SubuserAccess{},
+			Metadata: sendgrid.MetadataGetTeammateSubuserAccess{
+				NextParams: sendgrid.NextParams{Limit: int64(queryInt(r, "limit", 100))},
+			},
+		}
+		for _, a := range s.subuserAccess(t.SubuserAccess) {
+			out.SubuserAccess = append(out.SubuserAccess, sendgrid.SubuserAccess(a))
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/sso/teammates", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateSSOTeammate
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Email == "" {
+			writeError(w, http.StatusBadRequest, "email is required")
+			return
+		}
+		if s.teammateByEmail(in.Email) != nil || s.pendingTeammateByEmail(in.Email) != nil {
+			writeError(w, http.StatusBadRequest, "email already exists")
+			return
+		}
+
+		// SSO teammates are identified by their email address.
+		t := &teammate{
+			Teammate: sendgrid.Teammate{
+				Username:  in.Email,
+				Email:     in.Email,
+				FirstName: in.FirstName,
+				LastName:  in.LastName,
+				UserType:  userType(in.IsAdmin),
+				IsAdmin:   in.IsAdmin,
+			},
+			Scopes:                     in.Scopes,
+			IsSSO:                      true,
+			HasRestrictedSubuserAccess: in.HasRestrictedSubuserAccess,
+			SubuserAccess:              in.SubuserAccess,
+		}
+		s.teammates[t.Username] = t
+
+		writeJSON(w, http.StatusCreated, sendgrid.OutputCreateSSOTeammate{
+			FirstName:                  t.FirstName,
+			LastName:                   t.LastName,
+			Email:                      t.Email,
+			IsAdmin:                    t.IsAdmin,
+			IsSSO:                      t.IsSSO,
+			Scopes:                     t.Scopes,
+			HasRestrictedSubuserAccess: t.HasRestrictedSubuserAccess,
+			SubuserAccess:              s.subuserAccess(t.SubuserAccess),
+		})
+	})
+
+	s.handle("PATCH /v3/sso/teammates/{username}", func(w http.ResponseWriter, r *http.Request) {
+		t, ok := s.teammates[r.PathValue("username")]
+		if !ok || !t.IsSSO {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateSSOTeammate
+		if !decode(w, r, &in) {
+			return
+		}
+		t.FirstName = in.FirstName
+		t.LastName = in.LastName
+		t.IsAdmin = in.IsAdmin
+		t.UserType = userType(in.IsAdmin)
+		t.Scopes = in.Scopes
+		t.HasRestrictedSubuserAccess = in.HasRestrictedSubuserAccess
+		t.SubuserAccess = in.SubuserAccess
+
+		writeJSON(w, http.StatusOK, sendgrid.OutputUpdateSSOTeammate{
+			Username:                   t.Username,
+			UserType:                   t.UserType,
+			FirstName:                  t.FirstName,
+			LastName:                   t.LastName,
+			Email:                      t.Email,
+			IsAdmin:                    t.IsAdmin,
+			IsSSO:                      t.IsSSO,
+			Scopes:                     t.Scopes,
+			HasRestrictedSubuserAccess: t.HasRestrictedSubuserAccess,
+			SubuserAccess:              s.subuserAccess(t.SubuserAccess),
+		})
+	})
+}
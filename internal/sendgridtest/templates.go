@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type template struct {
+	sendgrid.Template
+
+	versions map[string]*sendgrid.OutputGetTemplateVersion
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// plainContent mimics SendGrid's generation of plain text content from HTML.
+func plainContent(html string) string {
+	return strings.TrimSpace(htmlTag.ReplaceAllString(html, ""))
+}
+
+func (t *template) output() sendgrid.OutputGetTemplate {
+	out := sendgrid.OutputGetTemplate{
+		ID:         t.ID,
+		Name:       t.Name,
+		Generation: t.Generation,
+		UpdatedAt:  t.UpdatedAt,
+		Versions:   []sendgrid.Version{},
+	}
+	for _, v := range sorted(t.versions) {
+		out.Versions = append(out.Versions, sendgrid.Version{
+			ID:                   v.ID,
+			TemplateID:           v.TemplateID,
+			Name:                 v.Name,
+			Subject:              v.Subject,
+			UpdatedAt:            v.UpdatedAt,
+			GeneratePlainContent: v.GeneratePlainContent,
+			HTMLContent:          v.HTMLContent,
+			PlainContent:         v.PlainContent,
+			Editor:               v.Editor,
+			ThumbnailURL:         v.ThumbnailURL,
+		})
+	}
+	return out
+}
+
+// versionResult converts v to the shape SendGrid returns from the endpoints
+// that change a version, which report warnings as a list.
+func versionResult(v *sendgrid.OutputGetTemplateVersion) sendgrid.OutputCreateTemplateVersion {
+	return sendgrid.OutputCreateTemplateVersion{
+		ID:                   v.ID,
+		TemplateID:           v.TemplateID,
+		Active:               v.Active,
+		Name:                 v.Name,
+		HTMLContent:          v.HTMLContent,
+		PlainContent:         v.PlainContent,
+		GeneratePlainContent: v.GeneratePlainContent,
+		Subject:              v.Subject,
+		Editor:               v.Editor,
+		TestData:             v.TestData,
+		UpdatedAt:            v.UpdatedAt,
+		ThumbnailURL:         v.ThumbnailURL,
+	}
+}
+
+func (t *template) activate(id string) {
+	for _, v := range t.versions {
+		v.Active = 0
+	}
+	t.versions[id].Active = 1
+}
+
+func (s *Server) routeTemplates() {
+	s.handle("GET /v3/templates", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		generations := strings.Split(q.Get("generations"), ",")
+		if q.Get("generations") == "" {
+			generations = []string{"legacy"}
+		}
+		pageSize, err := strconv.Atoi(q.Get("page_size"))
+		if err != nil || pageSize < 1 || pageSize > 200 {
+			writeError(w, http.StatusBadRequest, "page_size should be an integer between 1 and 200")
+			return
+		}
+		offset, _ := strconv.Atoi(q.Get("page_token"))
+
+		matched := []sendgrid.Template{}
+		for _, t := range sorted(s.templates) {
+			if slices.Contains(generations, t.Generation) {
+				o := t.output()
+				matched = append(matched, sendgrid.Template{
+					ID:         o.ID,
+					Name:       o.Name,
+					Generation: o.Generation,
+					UpdatedAt:  o.UpdatedAt,
+					Versions:   o.Versions,
+				})
+			}
+		}
+
+		out := sendgrid.OutputGetTemplates{
+			Templates: []sendgrid.Template{},
+			Metadata:  sendgrid.Metadata{Count: len(matched)},
+		}
+		if offset < len(matched) {
+			end := min(offset+pageSize, len(matched))
+			out.Templates = matched[offset:end]
+			if end < len(matched) {
+				out.Metadata.Next = fmt.Sprintf("%s?page_size=%d&page_token=%d", s.BaseURL()+"/templates", pageSize, end)
+			}
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/templates", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateTemplate
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name == "" {
+			writeError(w, http.StatusBadRequest, "name is required")
+			return
+		}
+		generation := in.Generation
+		if generation == "" {
+			generation = "legacy"
+		}
+		if generation != "legacy" && generation != "dynamic" {
+			writeError(w, http.StatusBadRequest, "generation must be legacy or dynamic")
+			return
+		}
+
+		id := s.nextUUID()
+		if generation == "dynamic" {
+			id = fmt.Sprintf("d-%032x", s.nextID())
+		}
+		t := &template{
+			Template: sendgrid.Template{
+				ID:         id,
+				Name:       in.Name,
+				Generation: generation,
+				UpdatedAt:  timestamp(),
+			},
+			versions: map[string]*sendgrid.OutputGetTemplateVersion{},
+		}
+		s.templates[id] = t
+
+		writeJSON(w, http.StatusCreated, t.output())
+	})
+
+	s.handle("GET /v3/templates/{id}", func(w http.ResponseWriter, r *http.Request) {
+		t, ok := s.templates[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, t.output())
+	})
+
+	s.handle("PATCH /v3/templates/{id}", func(w http.ResponseWriter, r *http.Request) {
+		t, ok := s.templates[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateTemplate
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name != "" {
+			t.Name = in.Name
+		}
+		t.UpdatedAt = timestamp()
+		writeJSON(w, http.StatusOK, t.output())
+	})
+
+	s.handle("DELETE /v3/templates/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.templates[id]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.templates, id)
+		writeNoContent(w)
+	})
+
+	s.handle("POST /v3/templates/{id}/versions", func(w http.ResponseWriter, r *http.Request) {
+		t, ok := s.templates[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputCreateTemplateVersion
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name == "" {
+			writeError(w, http.StatusBadRequest, "name is required")
+			return
+		}
+		editor := in.Editor
+		if editor == "" {
+			editor = "code"
+		}
+
+		id := s.nextUUID()
+		v := &sendgrid.OutputGetTemplateVersion{
+			ID:                   id,
+			TemplateID:           t.ID,
+			Name:                 in.Name,
+			HTMLContent:          in.HTMLContent,
+			PlainContent:         in.PlainContent,
+			GeneratePlainContent: in.GeneratePlainContent,
+			Subject:              in.Subject,
+			Editor:               editor,
+			TestData:             in.TestData,
+			UpdatedAt:            timestamp(),
+			ThumbnailURL:         fmt.Sprintf("https://fake.sendgrid.test/thumbnails/%s.png", id),
+		}
+		if v.GeneratePlainContent {
+			v.PlainContent = plainContent(v.HTMLContent)
+		}
+		t.versions[id] = v
+		// The first version created for a template is always active.
+		if in.Active == 1 || len(t.versions) == 1 {
+			t.activate(id)
+		}
+		t.UpdatedAt = v.UpdatedAt
+
+		writeJSON(w, http.StatusCreated, versionResult(v))
+	})
+
+	s.handle("GET /v3/templates/{id}/versions/{version}", func(w http.ResponseWriter, r *http.Request) {
+		_, v, ok := s.templateVersionByPath(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, v)
+	})
+
+	s.handle("PATCH /v3/templates/{id}/versions/{version}", func(w http.ResponseWriter, r *http.Request) {
+		t, v, ok := s.templateVersionByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputUpdateTemplateVersion
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.Name != "" {
+			v.Name = in.Name
+		}
+		if in.HTMLContent != "" {
+			v.HTMLContent = in.HTMLContent
+		}
+		if in.PlainContent != "" {
+			v.PlainContent = in.PlainContent
+		}
+		if in.Subject != "" {
+			v.Subject = in.Subject
+		}
+		if in.TestData != "" {
+			v.TestData = in.TestData
+		}
+		v.GeneratePlainContent = in.GeneratePlainContent
+		if v.GeneratePlainContent {
+			v.PlainContent = plainContent(v.HTMLContent)
+		}
+		if in.Active == 1 {
+			t.activate(v.ID)
+		}
+		v.UpdatedAt = timestamp()
+		writeJSON(w, http.StatusOK, versionResult(v))
+	})
+
+	s.handle("DELETE /v3/templates/{id}/versions/{version}", func(w http.ResponseWriter, r *http.Request) {
+		t, v, ok := s.templateVersionByPath(w, r)
+		if !ok {
+			return
+		}
+		delete(t.versions, v.ID)
+		writeNoContent(w)
+	})
+
+	s.handle("POST /v3/templates/{id}/versions/{version}/activate", func(w http.ResponseWriter, r *http.Request) {
+		t, v, ok := s.templateVersionByPath(w, r)
+		if !ok {
+			return
+		}
+		t.activate(v.ID)
+		writeJSON(w, http.StatusOK, versionResult(v))
+	})
+}
+
+func (s *Server) templateVersionByPath(w http.ResponseWriter, r *http.Request) (*template, *sendgrid.OutputGetTemplateVersion, bool) {
+	t, ok := s.templates[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w)
+		return nil, nil, false
+	}
+	v, ok := t.versions[r.PathValue("version")]
+	if !ok {
+		writeNotFound(w)
+		return nil, nil, false
+	}
+	return t, v, true
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+type verifiedSender struct {
+	sendgrid.VerifiedSender
+}
+
+// VerifySender marks the verified sender with the given from email as
+// verified, as if its owner had followed the link in the verification email.
+func (s *Server) VerifySender(fromEmail string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, v := range s.verifiedSenders {
+		if v.FromEmail == fromEmail {
+			v.Verified = true
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) routeVerifiedSenders() {
+	s.handle("GET /v3/verified_senders", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		id, _ := strconv.ParseInt(q.Get("id"), 10, 64)
+		lastSeenID, _ := strconv.ParseInt(q.Get("lastSeenID"), 10, 64)
+
+		out := sendgrid.OutputGetVerifiedSenders{VerifiedSenders: []*sendgrid.VerifiedSender{}}
+		for _, v := range sorted(s.verifiedSenders) {
+			if id != 0 && v.ID != id {
+				continue
+			}
+			if v.ID <= lastSeenID {
+				continue
+			}
+			vs := v.VerifiedSender
+			out.VerifiedSenders = append(out.VerifiedSenders, &vs)
+		}
+		if limit := queryInt(r, "limit", 0); limit > 0 && limit < len(out.VerifiedSenders) {
+			out.VerifiedSenders = out.VerifiedSenders[:limit]
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/verified_senders", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateVerifiedSenderRequest
+		if !decode(w, r, &in) {
+			return
+		}
+		switch {
+		case in.Nickname == "":
+			writeError(w, http.StatusBadRequest, "nickname is required")
+			return
+		case in.FromEmail == "":
+			writeError(w, http.StatusBadRequest, "from_email is required")
+			return
+		case in.ReplyTo == "":
+			writeError(w, http.StatusBadRequest, "reply_to is required")
+			return
+		}
+		for _, v := range s.verifiedSenders {
+			if v.FromEmail == in.FromEmail {
+				writeError(w, http.StatusBadRequest, "already exists")
+				return
+			}
+		}
+
+		v := &verifiedSender{sendgrid.VerifiedSender{
+			ID:          s.nextID(),
+			Nickname:    in.Nickname,
+			FromEmail:   in.FromEmail,
+			FromName:    in.FromName,
+			ReplyTo:     in.ReplyTo,
+			ReplyToName: in.ReplyToName,
+			Address:     in.Address,
+			Address2:    in.Address2,
+			State:       in.State,
+			City:        in.City,
+			Zip:         in.Zip,
+			Country:     in.Country,
+		}}
+		s.verifiedSenders[v.ID] = v
+
+		writeJSON(w, http.StatusCreated, v.VerifiedSender)
+	})
+
+	s.handle("PATCH /v3/verified_senders/{id}", func(w http.ResponseWriter, r *http.Request) {
+		v, ok := s.verifiedSenderByPath(w, r)
+		if !ok {
+			return
+		}
+		var in sendgrid.InputUpdateVerifiedSender
+		if !decode(w, r, &in) {
+			return
+		}
+		update := func(dst *string, src string) {
+			if src != "" {
+				*dst = src
+			}
+		}
+		update(&v.Nickname, in.Nickname)
+		update(&v.FromName, in.FromName)
+		update(&v.ReplyTo, in.ReplyTo)
+		update(&v.ReplyToName, in.ReplyToName)
+		update(&v.Address, in.Address)
+		update(&v.Address2, in.Address2)
+		update(&v.State, in.State)
+		update(&v.City, in.City)
+		update(&v.Zip, in.Zip)
+		update(&v.Country, in.Country)
+		// Changing the from address requires verifying it again.
+		if in.FromEmail != "" && in.FromEmail != v.FromEmail {
+			v.FromEmail = in.FromEmail
+			v.Verified = false
+		}
+		writeJSON(w, http.StatusOK, v.VerifiedSender)
+	})
+
+	s.handle("DELETE /v3/verified_senders/{id}", func(w http.ResponseWriter, r *http.Request) {
+		v, ok := s.verifiedSenderByPath(w, r)
+		if !ok {
+			return
+		}
+		delete(s.verifiedSenders, v.ID)
+		writeNoContent(w)
+	})
+
+	s.handle("POST /v3/verified_senders/resend/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.verifiedSenderByPath(w, r); !ok {
+			return
+		}
+		writeNoContent(w)
+	})
+}
+
+func (s *Server) verifiedSenderByPath(w http.ResponseWriter, r *http.Request) (*verifiedSender, bool) {
+	id, ok := pathInt64(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	v, ok := s.verifiedSenders[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return v, true
+}
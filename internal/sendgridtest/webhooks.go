@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kenzo0107/sendgrid"
+)
+
+// maxEventWebhooks is the number of event webhooks an account may have.
+const maxEventWebhooks = 10
+
+type eventWebhook struct {
+	sendgrid.EventWebhook
+
+	CreatedDate string
+	UpdatedDate string
+}
+
+// apply copies the writable settings of an event webhook request.
+func (e *eventWebhook) apply(in sendgrid.InputUpdateEventWebhook) {
+	e.Enabled = in.Enabled
+	e.URL = in.URL
+	e.GroupResubscribe = in.GroupResubscribe
+	e.Delivered = in.Delivered
+	e.GroupUnsubscribe = in.GroupUnsubscribe
+	e.SpamReport = in.SpamReport
+	e.Bounce = in.Bounce
+	e.Deferred = in.Deferred
+	e.Unsubscribe = in.Unsubscribe
+	e.Processed = in.Processed
+	e.Open = in.Open
+	e.Click = in.Click
+	e.Dropped = in.Dropped
+	e.FriendlyName = in.FriendlyName
+	e.OAuthClientID = in.OAuthClientID
+	e.OAuthTokenURL = in.OAuthTokenURL
+}
+
+func (e *eventWebhook) output() sendgrid.OutputUpdateEventWebhook {
+	return sendgrid.OutputUpdateEventWebhook{
+		ID:               e.ID,
+		Enabled:          e.Enabled,
+		URL:              e.URL,
+		GroupResubscribe: e.GroupResubscribe,
+		Delivered:        e.Delivered,
+		GroupUnsubscribe: e.GroupUnsubscribe,
+		SpamReport:       e.SpamReport,
+		Bounce:           e.Bounce,
+		Deferred:         e.Deferred,
+		Unsubscribe:      e.Unsubscribe,
+		Processed:        e.Processed,
+		Open:             e.Open,
+		Click:            e.Click,
+		Dropped:          e.Dropped,
+		FriendlyName:     e.FriendlyName,
+		CreatedDate:      e.CreatedDate,
+		UpdatedDate:      e.UpdatedDate,
+		OAuthClientID:    e.OAuthClientID,
+		OAuthTokenURL:    e.OAuthTokenURL,
+	}
+}
+
+type parseWebhook struct {
+	sendgrid.InboundParseWebhook
+}
+
+func (s *Server) routeWebhooks() {
+	s.handle("GET /v3/user/webhooks/event/settings/all", func(w http.ResponseWriter, r *http.Request) {
+		out := sendgrid.OutputGetEventWebhooks{
+			MaxAllowed: maxEventWebhooks,
+			Webhooks:   []*sendgrid.EventWebhook{},
+		}
+		for _, e := range sorted(s.eventWebhooks) {
+			v := e.EventWebhook
+			out.Webhooks = append(out.Webhooks, &v)
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/user/webhooks/event/settings", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateEventWebhook
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.URL == "" {
+			writeError(w, http.StatusBadRequest, "url is required")
+			return
+		}
+		if len(s.eventWebhooks) >= maxEventWebhooks {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("a maximum of %d event webhooks is allowed", maxEventWebhooks))
+			return
+		}
+
+		e := &eventWebhook{CreatedDate: timestamp()}
+		e.ID = s.nextUUID()
+		e.UpdatedDate = e.CreatedDate
+		e.apply(sendgrid.InputUpdateEventWebhook(in))
+		s.eventWebhooks[e.ID] = e
+
+		writeJSON(w, http.StatusCreated, e.output())
+	})
+
+	s.handle("GET /v3/user/webhooks/event/settings/{id}", func(w http.ResponseWriter, r *http.Request) {
+		e, ok := s.eventWebhooks[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, e.EventWebhook)
+	})
+
+	s.handle("PATCH /v3/user/webhooks/event/settings/{id}", func(w http.ResponseWriter, r *http.Request) {
+		e, ok := s.eventWebhooks[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateEventWebhook
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.URL == "" {
+			in.URL = e.URL
+		}
+		e.apply(in)
+		e.UpdatedDate = timestamp()
+		writeJSON(w, http.StatusOK, e.output())
+	})
+
+	s.handle("DELETE /v3/user/webhooks/event/settings/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.eventWebhooks[id]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.eventWebhooks, id)
+		writeNoContent(w)
+	})
+
+	s.handle("GET /v3/user/webhooks/event/settings/signed/{id}", func(w http.ResponseWriter, r *http.Request) {
+		e, ok := s.eventWebhooks[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, sendgrid.OutputGetSignedEventWebhooksPublicKey{PublicKey: e.PublicKey})
+	})
+
+	s.handle("PATCH /v3/user/webhooks/event/settings/signed/{id}", func(w http.ResponseWriter, r *http.Request) {
+		e, ok := s.eventWebhooks[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputToggleSignatureVerification
+		if !decode(w, r, &in) {
+			return
+		}
+		e.PublicKey = ""
+		if in.Enabled {
+			e.PublicKey = fmt.Sprintf("MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE%s==", e.ID)
+		}
+		writeJSON(w, http.StatusOK, sendgrid.OutputToggleSignatureVerification{
+			ID:        e.ID,
+			PublicKey: e.PublicKey,
+		})
+	})
+
+	s.handle("GET /v3/user/webhooks/parse/settings", func(w http.ResponseWriter, r *http.Request) {
+		out := sendgrid.OutputGetInboundParseWebhooks{Result: []*sendgrid.InboundParseWebhook{}}
+		for _, p := range sorted(s.parseWebhooks) {
+			v := p.InboundParseWebhook
+			out.Result = append(out.Result, &v)
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+
+	s.handle("POST /v3/user/webhooks/parse/settings", func(w http.ResponseWriter, r *http.Request) {
+		var in sendgrid.InputCreateInboundParseWebhook
+		if !decode(w, r, &in) {
+			return
+		}
+		switch {
+		case in.Hostname == "":
+			writeError(w, http.StatusBadRequest, "hostname is required")
+			return
+		case in.URL == "":
+			writeError(w, http.StatusBadRequest, "url is required")
+			return
+		}
+		if _, ok := s.parseWebhooks[in.Hostname]; ok {
+			writeError(w, http.StatusBadRequest, "A setting with this hostname already exists.")
+			return
+		}
+
+		p := &parseWebhook{sendgrid.InboundParseWebhook(in)}
+		s.parseWebhooks[p.Hostname] = p
+
+		writeJSON(w, http.StatusCreated, p.InboundParseWebhook)
+	})
+
+	s.handle("GET /v3/user/webhooks/parse/settings/{hostname}", func(w http.ResponseWriter, r *http.Request) {
+		p, ok := s.parseWebhooks[r.PathValue("hostname")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, p.InboundParseWebhook)
+	})
+
+	s.handle("PATCH /v3/user/webhooks/parse/settings/{hostname}", func(w http.ResponseWriter, r *http.Request) {
+		p, ok := s.parseWebhooks[r.PathValue("hostname")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var in sendgrid.InputUpdateInboundParseWebhook
+		if !decode(w, r, &in) {
+			return
+		}
+		if in.URL != "" {
+			p.URL = in.URL
+		}
+		p.SpamCheck = in.SpamCheck
+		p.SendRaw = in.SendRaw
+		writeJSON(w, http.StatusOK, p.InboundParseWebhook)
+	})
+
+	s.handle("DELETE /v3/user/webhooks/parse/settings/{hostname}", func(w http.ResponseWriter, r *http.Request) {
+		hostname := r.PathValue("hostname")
+		if _, ok := s.parseWebhooks[hostname]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(s.parseWebhooks, hostname)
+		writeNoContent(w)
+	})
+}